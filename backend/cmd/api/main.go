@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
 	"log"
 
+	"github.com/helberthlucas14/internal/authz"
 	"github.com/helberthlucas14/internal/domain"
 	"github.com/helberthlucas14/internal/middleware"
 
 	"github.com/helberthlucas14/internal/infra/database"
+	"github.com/helberthlucas14/internal/infra/geocoding"
+	"github.com/helberthlucas14/internal/infra/mail"
+	"github.com/helberthlucas14/internal/infra/storage"
 
 	"github.com/helberthlucas14/internal/infra/web"
 
 	"github.com/helberthlucas14/internal/infra/repository"
+	"github.com/helberthlucas14/internal/infra/scheduler"
 
 	"github.com/helberthlucas14/internal/usecase"
 
@@ -36,25 +42,61 @@ func main() {
 
 	docs.SwaggerInfo.Host = "localhost:" + cfg.Port
 
+	geocoder := geocoding.NewGeocoder(cfg)
+
 	// 1. Database
 	database.Connect(cfg)
-	database.Migrate(&domain.User{}, &domain.Job{}, &domain.Application{})
+	database.Migrate(&domain.User{}, &domain.Job{}, &domain.Application{}, &domain.RefreshToken{}, &domain.UserToken{},
+		&domain.Organization{}, &domain.OrganizationMember{}, &domain.OrganizationInvitation{},
+		&domain.PipelineStage{}, &domain.ApplicationEvent{}, &domain.ApplicationAttachment{},
+		&domain.CandidateProfile{}, &domain.WorkExperience{}, &domain.Education{},
+		&domain.ScreeningQuestion{}, &domain.ScreeningAnswer{}, &domain.Category{},
+		&domain.JobApproval{}, &domain.ApplicationNote{}, &domain.NoteMention{}, &domain.NoteRevision{},
+		&domain.ScorecardCriterion{}, &domain.Scorecard{}, &domain.ScorecardRating{},
+		&domain.Interview{}, &domain.InterviewParticipant{}, &domain.InterviewSlot{},
+		&domain.Offer{})
+	database.MigrateData(geocoder)
 
 	// Initialize Repositories (Infra)
 	userRepo := &repository.UserRepository{}
 	jobRepo := &repository.JobRepository{}
 	appRepo := &repository.ApplicationRepository{}
+	refreshTokenRepo := &repository.RefreshTokenRepository{}
+	userTokenRepo := &repository.UserTokenRepository{}
+	orgRepo := &repository.OrganizationRepository{}
+	pipelineRepo := &repository.PipelineRepository{}
+	profileRepo := &repository.CandidateProfileRepository{}
+	categoryRepo := &repository.CategoryRepository{}
+	approvalRepo := &repository.JobApprovalRepository{}
+	noteRepo := &repository.ApplicationNoteRepository{}
+	scorecardRepo := &repository.ScorecardRepository{}
+	interviewRepo := &repository.InterviewRepository{}
+	slotRepo := &repository.InterviewSlotRepository{}
+	offerRepo := &repository.OfferRepository{}
+
+	// Initialize Services (Infra)
+	mailer := mail.NewSender(cfg)
+	fileStorage := storage.NewStorage(cfg)
 
 	// Initialize UseCases
-	authUseCase := usecase.NewAuthUseCase(userRepo, cfg.JWTSecret)
-	jobUseCase := usecase.NewJobUseCase(jobRepo, appRepo)
-	appUseCase := usecase.NewApplicationUseCase(appRepo, jobRepo)
+	policy := authz.NewPolicy(orgRepo)
+	authUseCase := usecase.NewAuthUseCase(userRepo, refreshTokenRepo, userTokenRepo, mailer, cfg.JWTSecret, cfg.AppURL)
+	jobUseCase := usecase.NewJobUseCase(jobRepo, appRepo, orgRepo, pipelineRepo, categoryRepo, approvalRepo, offerRepo, geocoder, mailer, policy, cfg.AppURL)
+	appUseCase := usecase.NewApplicationUseCase(appRepo, jobRepo, pipelineRepo, profileRepo, noteRepo, scorecardRepo, interviewRepo, slotRepo, orgRepo, fileStorage, mailer, policy, cfg.AppURL)
+	orgUseCase := usecase.NewOrganizationUseCase(orgRepo, userRepo, mailer, policy, cfg.AppURL)
+	pipelineUseCase := usecase.NewPipelineUseCase(pipelineRepo, jobRepo, orgRepo, policy)
+	profileUseCase := usecase.NewProfileUseCase(profileRepo, policy)
+	categoryUseCase := usecase.NewCategoryUseCase(categoryRepo, policy)
 
 	// Initialize Handlers
 	authHandler := web.NewAuthHandler(authUseCase)
 	jobHandler := web.NewJobHandler(jobUseCase)
 	appHandler := web.NewApplicationHandler(appUseCase)
 	dashboardHandler := web.NewDashboardHandler(appUseCase)
+	orgHandler := web.NewOrganizationHandler(orgUseCase)
+	pipelineHandler := web.NewPipelineHandler(pipelineUseCase)
+	profileHandler := web.NewProfileHandler(profileUseCase)
+	categoryHandler := web.NewCategoryHandler(categoryUseCase)
 
 	// Setup Router
 	r := gin.Default()
@@ -85,29 +127,106 @@ func main() {
 	// Public Routes
 	r.POST("/register", authHandler.Register)
 	r.POST("/login", authHandler.Login)
+	r.POST("/token/refresh", authHandler.Refresh)
+	r.POST("/password/forgot", authHandler.ForgotPassword)
+	r.POST("/password/reset", authHandler.ResetPassword)
+	r.POST("/verify-email", authHandler.VerifyEmail)
+	r.POST("/verify-email/resend", authHandler.ResendVerification)
 	r.GET("/jobs", jobHandler.GetJobs)
-	r.GET("/jobs/:id", jobHandler.GetJob)
+	r.GET("/jobs/:id", middleware.OptionalAuthMiddleware(cfg.JWTSecret, refreshTokenRepo), jobHandler.GetJob)
+	r.GET("/categories", categoryHandler.GetCategories)
+	if local, ok := fileStorage.(*storage.LocalStorage); ok {
+		r.GET("/files/*key", web.NewFileHandler(local).Download)
+	}
 
 	// Protected Routes
 	protected := r.Group("/")
-	protected.Use(middleware.AuthMiddleware(cfg.JWTSecret))
+	protected.Use(middleware.AuthMiddleware(cfg.JWTSecret, refreshTokenRepo))
+	requireVerified := middleware.RequireVerifiedEmail(userRepo)
 	{
+		protected.POST("/logout", authHandler.Logout)
+
 		// Recruiter
-		protected.POST("/jobs", jobHandler.CreateJob)
+		protected.POST("/jobs", requireVerified, jobHandler.CreateJob)
 		protected.GET("/jobs/mine", jobHandler.GetMyJobs)
 		protected.PATCH("/jobs/:id", jobHandler.UpdateJob)
+		protected.POST("/jobs/:id/publish", requireVerified, jobHandler.PublishJob)
+		protected.POST("/jobs/:id/submit", requireVerified, jobHandler.SubmitJob)
+		protected.POST("/jobs/:id/approve", jobHandler.ApproveJob)
+		protected.POST("/jobs/:id/reject", jobHandler.RejectJob)
+		protected.GET("/jobs/:id/approvals", jobHandler.GetJobApprovals)
+		protected.GET("/approvals", jobHandler.GetApprovalQueue)
 		protected.POST("/jobs/:id/finalize", jobHandler.FinalizeJob)
+		protected.POST("/jobs/:id/hire", jobHandler.HireCandidate)
+		protected.POST("/jobs/:id/reopen", jobHandler.ReopenJob)
+		protected.POST("/jobs/:id/archive", jobHandler.ArchiveJob)
+		protected.POST("/jobs/:id/unarchive", jobHandler.UnarchiveJob)
+		protected.DELETE("/jobs/:id", jobHandler.DeleteJob)
+		protected.POST("/jobs/:id/restore", jobHandler.RestoreJob)
 		protected.GET("/jobs/:id/applications", appHandler.GetJobApplications)
+		protected.GET("/jobs/:id/interview-slots", appHandler.GetJobInterviewSlots)
+		protected.POST("/jobs/:id/interview-slots", appHandler.PublishInterviewSlots)
+		protected.DELETE("/jobs/:id/interview-slots/:slotId", appHandler.DeleteInterviewSlot)
+		protected.GET("/jobs/:id/pipeline", pipelineHandler.GetJobPipeline)
+		protected.PUT("/jobs/:id/pipeline", pipelineHandler.UpdateJobPipeline)
+		protected.POST("/applications/:id/move", appHandler.MoveApplication)
+		protected.GET("/applications/:id/timeline", appHandler.GetTimeline)
+		protected.GET("/applications/:id/notes", appHandler.GetNotes)
+		protected.POST("/applications/:id/notes", appHandler.AddNote)
+		protected.PATCH("/applications/:id/notes/:noteId", appHandler.UpdateNote)
+		protected.GET("/applications/:id/scorecards", appHandler.GetScorecards)
+		protected.PUT("/applications/:id/scorecard", appHandler.SubmitScorecard)
+		protected.GET("/applications/:id/interviews", appHandler.GetInterviews)
+		protected.POST("/applications/:id/interviews", appHandler.ScheduleInterview)
+		protected.POST("/applications/:id/interviews/:interviewId/reschedule", appHandler.RescheduleInterview)
+		protected.POST("/applications/:id/interviews/:interviewId/cancel", appHandler.CancelInterview)
+		protected.POST("/applications/:id/interviews/:interviewId/outcome", appHandler.RecordInterviewOutcome)
+		protected.GET("/applications/:id/interviews/:interviewId/calendar", appHandler.InterviewCalendar)
+		protected.GET("/applications/:id/offers", jobHandler.GetOffers)
+		protected.POST("/applications/:id/offers", jobHandler.CreateOffer)
+		protected.PATCH("/offers/:id", jobHandler.UpdateOffer)
+		protected.POST("/offers/:id/send", jobHandler.SendOffer)
+		protected.GET("/offers/:id/letter", jobHandler.OfferLetter)
+
+		// Organizations
+		protected.POST("/organizations", orgHandler.CreateOrganization)
+		protected.GET("/organizations/mine", orgHandler.GetMyOrganizations)
+		protected.PATCH("/organizations/:id", orgHandler.UpdateOrganization)
+		protected.GET("/organizations/:id/members", orgHandler.GetMembers)
+		protected.PATCH("/organizations/:id/members/:userId", orgHandler.UpdateMember)
+		protected.DELETE("/organizations/:id/members/:userId", orgHandler.RemoveMember)
+		protected.POST("/organizations/:id/invitations", orgHandler.InviteMember)
+		protected.GET("/organizations/:id/invitations", orgHandler.GetInvitations)
+		protected.DELETE("/organizations/:id/invitations/:invitationId", orgHandler.RevokeInvitation)
+		protected.GET("/organizations/:id/pipeline", pipelineHandler.GetOrganizationPipeline)
+		protected.PUT("/organizations/:id/pipeline", pipelineHandler.UpdateOrganizationPipeline)
+		protected.POST("/invitations/accept", orgHandler.AcceptInvitation)
 
 		// Candidate
-		protected.POST("/jobs/:id/apply", appHandler.ApplyJob)
+		protected.POST("/jobs/:id/apply", requireVerified, appHandler.ApplyJob)
 		protected.GET("/applications", appHandler.MyApplications)
 		protected.PATCH("/applications/:id/cancel", appHandler.CancelApplication)
+		protected.GET("/applications/:id/interview-slots", appHandler.GetOpenInterviewSlots)
+		protected.POST("/applications/:id/interview-slots", appHandler.BookInterviewSlot)
+		protected.POST("/offers/:id/accept", jobHandler.AcceptOffer)
+		protected.POST("/offers/:id/decline", jobHandler.DeclineOffer)
+		protected.GET("/me/profile", profileHandler.GetMyProfile)
+		protected.POST("/me/profile", profileHandler.CreateMyProfile)
+		protected.PUT("/me/profile", profileHandler.UpdateMyProfile)
+		protected.DELETE("/me/profile", profileHandler.DeleteMyProfile)
+
+		// Admin
+		protected.POST("/categories", categoryHandler.CreateCategory)
+		protected.PATCH("/categories/:id", categoryHandler.UpdateCategory)
+		protected.DELETE("/categories/:id", categoryHandler.DeleteCategory)
 
 		// Dashboard
 		protected.GET("/dashboard/summary", dashboardHandler.GetSummary)
 	}
 
+	// Background jobs
+	go scheduler.Every(context.Background(), cfg.SchedulerInterval, "job publication and expiration", jobUseCase.RunSchedule)
+
 	port := ":" + cfg.Port
 	log.Println("Server executing on port", port)
 	r.Run(port)
@@ -3,17 +3,19 @@ package main
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/helberthlucas14/internal/config"
 	"github.com/helberthlucas14/internal/domain"
 	"github.com/helberthlucas14/internal/infra/database"
+	"github.com/helberthlucas14/internal/infra/geocoding"
 	"golang.org/x/crypto/bcrypt"
 )
 
 func main() {
 	cfg := config.LoadConfig()
 	database.Connect(cfg)
-	database.Migrate(&domain.User{}, &domain.Job{}, &domain.Application{})
+	database.Migrate(&domain.User{}, &domain.Organization{}, &domain.OrganizationMember{}, &domain.Category{}, &domain.Job{}, &domain.Application{})
 
 	var jobCount int64
 	database.DB.Model(&domain.Job{}).Count(&jobCount)
@@ -26,13 +28,46 @@ func main() {
 	if err != nil {
 		log.Fatalf("Seed: failed to hash password: %v", err)
 	}
-	recruiter := domain.User{Name: "Teste Recruiter", Email: "teste@empresa.com", Password: string(hash), Role: domain.RoleRecruiter}
+	verifiedAt := time.Now()
+	recruiter := domain.User{Name: "Teste Recruiter", Email: "teste@empresa.com", Password: string(hash), Role: domain.RoleRecruiter, EmailVerifiedAt: &verifiedAt}
 	if err := database.DB.Create(&recruiter).Error; err != nil {
 		log.Fatalf("Seed: failed to create recruiter: %v", err)
 	}
+	admin := domain.User{Name: "Teste Admin", Email: "admin@empresa.com", Password: string(hash), Role: domain.RoleAdmin, EmailVerifiedAt: &verifiedAt}
+	if err := database.DB.Create(&admin).Error; err != nil {
+		log.Fatalf("Seed: failed to create admin: %v", err)
+	}
+
+	var categories []domain.Category
+	database.DB.Find(&categories)
+	if len(categories) == 0 {
+		categories = append(categories, domain.DefaultCategories...)
+		if err := database.DB.Create(&categories).Error; err != nil {
+			log.Fatalf("Seed: failed to create categories: %v", err)
+		}
+	}
+
+	org := domain.Organization{Name: "Empresa Demo"}
+	if err := database.DB.Create(&org).Error; err != nil {
+		log.Fatalf("Seed: failed to create organization: %v", err)
+	}
+	owner := domain.OrganizationMember{OrganizationID: org.ID, UserID: recruiter.ID, Role: domain.OrgRoleOwner}
+	if err := database.DB.Create(&owner).Error; err != nil {
+		log.Fatalf("Seed: failed to create organization owner: %v", err)
+	}
+
+	employmentTypes := []domain.EmploymentType{domain.EmploymentCLT, domain.EmploymentPJ, domain.EmploymentContract, domain.EmploymentInternship}
+	seniorities := []domain.Seniority{domain.SeniorityJunior, domain.SeniorityMid, domain.SenioritySenior, domain.SeniorityLead}
+	workModels := []domain.WorkModel{domain.WorkRemote, domain.WorkHybrid, domain.WorkOnsite}
+	locations := []string{"Remoto", "São Paulo - SP", "Belo Horizonte - MG", "Contagem - MG", "Rio de Janeiro - RJ", "Curitiba - PR", "Recife - PE"}
+	gazetteer := geocoding.NewGazetteer()
 
 	for i := 1; i <= 100; i++ {
-		job := domain.Job{Title: fmt.Sprintf("Vaga #%d", i), Description: "Descrição da vaga", Company: "Empresa Demo", Location: "Remoto", Requirements: "Requisitos básicos", Salary: "8000", RecruiterID: recruiter.ID, Anonymous: false}
+		salaryMin, salaryMax := 6000+(i%5)*1000, 9000+(i%5)*1000
+		job := domain.Job{Title: fmt.Sprintf("Vaga #%d", i), Description: "Descrição da vaga", Company: org.Name, Location: locations[i%len(locations)], Requirements: "Requisitos básicos", CategoryID: &categories[i%len(categories)].ID, EmploymentType: employmentTypes[i%len(employmentTypes)], Seniority: seniorities[i%len(seniorities)], WorkModel: workModels[i%len(workModels)], SalaryMin: &salaryMin, SalaryMax: &salaryMax, SalaryCurrency: domain.DefaultSalaryCurrency, SalaryPeriod: domain.SalaryPerMonth, RecruiterID: recruiter.ID, OrganizationID: &org.ID, Anonymous: false}
+		if point, ok, _ := gazetteer.Geocode(job.Location); ok {
+			job.Latitude, job.Longitude = &point.Lat, &point.Lng
+		}
 		if err := database.DB.Create(&job).Error; err != nil {
 			log.Printf("Seed: failed to create job %d: %v", i, err)
 		}
@@ -0,0 +1,213 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type Action string
+
+const (
+	ActionJobCreate           Action = "job:create"
+	ActionJobListMine         Action = "job:list_mine"
+	ActionJobUpdate           Action = "job:update"
+	ActionJobPublish          Action = "job:publish"
+	ActionJobSubmit           Action = "job:submit"
+	ActionJobApprove          Action = "job:approve"
+	ActionJobListApprovals    Action = "job:list_approvals"
+	ActionJobViewApprovals    Action = "job:view_approvals"
+	ActionJobFinalize         Action = "job:finalize"
+	ActionJobHire             Action = "job:hire"
+	ActionJobReopen           Action = "job:reopen"
+	ActionJobArchive          Action = "job:archive"
+	ActionJobDelete           Action = "job:delete"
+	ActionJobViewApplications Action = "job:view_applications"
+	ActionJobManagePipeline   Action = "job:manage_pipeline"
+	ActionJobManageSlots      Action = "job:manage_slots"
+
+	ActionApplicationCreate     Action = "application:create"
+	ActionApplicationListMine   Action = "application:list_mine"
+	ActionApplicationCancel     Action = "application:cancel"
+	ActionApplicationMove       Action = "application:move"
+	ActionApplicationTimeline   Action = "application:timeline"
+	ActionApplicationNotes      Action = "application:notes"
+	ActionApplicationNoteEdit   Action = "application:note_edit"
+	ActionApplicationScore      Action = "application:score"
+	ActionApplicationSchedule   Action = "application:schedule"
+	ActionApplicationInterviews Action = "application:interviews"
+	ActionApplicationBook       Action = "application:book"
+	ActionApplicationOffer      Action = "application:offer"
+	ActionApplicationOffers     Action = "application:offers"
+	ActionApplicationRespond    Action = "application:respond"
+
+	ActionDashboardView Action = "dashboard:view"
+
+	ActionProfileManage Action = "profile:manage"
+
+	ActionCategoryManage Action = "category:manage"
+
+	ActionOrganizationCreate   Action = "organization:create"
+	ActionOrganizationListMine Action = "organization:list_mine"
+	ActionOrganizationView     Action = "organization:view"
+	ActionOrganizationManage   Action = "organization:manage"
+	ActionOrganizationJoin     Action = "organization:join"
+)
+
+var descriptions = map[Action]string{
+	ActionJobCreate:             "create jobs for this organization",
+	ActionJobListMine:           "list recruiter jobs",
+	ActionJobUpdate:             "update this job",
+	ActionJobPublish:            "publish this job",
+	ActionJobSubmit:             "submit this job for approval",
+	ActionJobApprove:            "decide on this approval request",
+	ActionJobListApprovals:      "list approval requests",
+	ActionJobViewApprovals:      "view the approvals of this job",
+	ActionJobFinalize:           "finalize this job",
+	ActionJobHire:               "hire for this job",
+	ActionJobReopen:             "reopen this job",
+	ActionJobArchive:            "archive this job",
+	ActionJobDelete:             "delete or restore this job",
+	ActionJobViewApplications:   "view applications for this job",
+	ActionJobManagePipeline:     "change the pipeline of this job",
+	ActionJobManageSlots:        "publish interview availability for this job",
+	ActionApplicationCreate:     "apply to jobs",
+	ActionApplicationListMine:   "list candidate applications",
+	ActionApplicationCancel:     "cancel this application",
+	ActionApplicationMove:       "move this application",
+	ActionApplicationTimeline:   "view the timeline of this application",
+	ActionApplicationNotes:      "read or write notes on this application",
+	ActionApplicationNoteEdit:   "edit this note",
+	ActionApplicationScore:      "score this application",
+	ActionApplicationSchedule:   "schedule interviews for this application",
+	ActionApplicationInterviews: "view the interviews of this application",
+	ActionApplicationBook:       "book an interview for this application",
+	ActionApplicationOffer:      "make offers for this application",
+	ActionApplicationOffers:     "view the offers of this application",
+	ActionApplicationRespond:    "answer offers for this application",
+	ActionDashboardView:         "view the dashboard",
+	ActionProfileManage:         "manage a candidate profile",
+	ActionCategoryManage:        "manage job categories",
+	ActionOrganizationCreate:    "create organizations",
+	ActionOrganizationListMine:  "list organizations",
+	ActionOrganizationView:      "view this organization",
+	ActionOrganizationManage:    "manage this organization",
+	ActionOrganizationJoin:      "join organizations",
+}
+
+var ErrForbidden = errors.New("forbidden")
+
+// Subject is the authenticated user a decision is made for. Memberships maps
+// organization IDs to the user's role in them and only needs to be filled for
+// recruiters.
+type Subject struct {
+	UserID      uint
+	Role        domain.Role
+	Memberships map[uint]domain.OrganizationRole
+}
+
+func (s Subject) IsCandidate() bool {
+	return s.Role == domain.RoleCandidate
+}
+
+func (s Subject) IsRecruiter() bool {
+	return s.Role == domain.RoleRecruiter
+}
+
+func (s Subject) IsAdmin() bool {
+	return s.Role == domain.RoleAdmin
+}
+
+func (s Subject) MemberOf(orgID uint) bool {
+	_, ok := s.Memberships[orgID]
+	return ok
+}
+
+func (s Subject) OwnerOf(orgID uint) bool {
+	return s.Memberships[orgID] == domain.OrgRoleOwner
+}
+
+func (s Subject) ApproverOf(orgID uint) bool {
+	return s.Memberships[orgID].CanApproveJobs()
+}
+
+// Can is the single place that decides whether subject may perform action on
+// resource. Resource is the domain object being acted on (*domain.Job,
+// *domain.JobApproval, *domain.Application, *domain.ApplicationNote,
+// *domain.Organization) or nil for collection actions.
+func Can(subject Subject, action Action, resource any) bool {
+	if subject.UserID == 0 {
+		return false
+	}
+
+	switch action {
+	case ActionJobCreate:
+		org, ok := resource.(*domain.Organization)
+		return subject.IsRecruiter() && (!ok || subject.MemberOf(org.ID))
+	case ActionJobListMine, ActionJobListApprovals, ActionOrganizationCreate, ActionOrganizationListMine, ActionOrganizationJoin:
+		return subject.IsRecruiter()
+	case ActionJobUpdate, ActionJobPublish, ActionJobSubmit, ActionJobViewApprovals, ActionJobFinalize, ActionJobHire, ActionJobReopen, ActionJobArchive, ActionJobDelete, ActionJobViewApplications, ActionJobManagePipeline, ActionJobManageSlots:
+		job, ok := resource.(*domain.Job)
+		return ok && subject.IsRecruiter() && managesJob(subject, job)
+	case ActionJobApprove:
+		// Nobody approves their own request.
+		approval, ok := resource.(*domain.JobApproval)
+		return ok && subject.IsRecruiter() && subject.ApproverOf(approval.OrganizationID) && approval.RequestedByID != subject.UserID
+	case ActionApplicationCreate, ActionApplicationListMine, ActionProfileManage:
+		return subject.IsCandidate()
+	case ActionApplicationCancel, ActionApplicationBook, ActionApplicationRespond:
+		app, ok := resource.(*domain.Application)
+		return ok && subject.IsCandidate() && app.CandidateID == subject.UserID
+	case ActionApplicationMove, ActionApplicationNotes, ActionApplicationScore, ActionApplicationSchedule, ActionApplicationOffer:
+		app, ok := resource.(*domain.Application)
+		return ok && subject.IsRecruiter() && managesJob(subject, &app.Job)
+	case ActionApplicationNoteEdit:
+		// Only the author edits a note, and only while still on the team.
+		note, ok := resource.(*domain.ApplicationNote)
+		return ok && subject.IsRecruiter() && note.AuthorID == subject.UserID && managesJob(subject, &note.Application.Job)
+	case ActionApplicationTimeline, ActionApplicationInterviews, ActionApplicationOffers:
+		app, ok := resource.(*domain.Application)
+		if !ok {
+			return false
+		}
+		if subject.IsCandidate() {
+			return app.CandidateID == subject.UserID
+		}
+		return subject.IsRecruiter() && managesJob(subject, &app.Job)
+	case ActionCategoryManage:
+		return subject.IsAdmin()
+	case ActionDashboardView:
+		return subject.IsCandidate() || subject.IsRecruiter()
+	case ActionOrganizationView:
+		org, ok := resource.(*domain.Organization)
+		return ok && subject.IsRecruiter() && subject.MemberOf(org.ID)
+	case ActionOrganizationManage:
+		org, ok := resource.(*domain.Organization)
+		return ok && subject.IsRecruiter() && subject.OwnerOf(org.ID)
+	}
+
+	return false
+}
+
+// Authorize is Can returning an error wrapping ErrForbidden on denial.
+func Authorize(subject Subject, action Action, resource any) error {
+	if Can(subject, action, resource) {
+		return nil
+	}
+	return fmt.Errorf("%w: you are not allowed to %s", ErrForbidden, describe(action))
+}
+
+func managesJob(subject Subject, job *domain.Job) bool {
+	if job.OrganizationID == nil {
+		return job.RecruiterID == subject.UserID
+	}
+	return subject.MemberOf(*job.OrganizationID)
+}
+
+func describe(action Action) string {
+	if d, ok := descriptions[action]; ok {
+		return d
+	}
+	return string(action)
+}
@@ -0,0 +1,246 @@
+package authz
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+const (
+	orgID      uint = 100
+	otherOrgID uint = 200
+)
+
+var (
+	candidate      = Subject{UserID: 1, Role: domain.RoleCandidate}
+	otherCandidate = Subject{UserID: 2, Role: domain.RoleCandidate}
+	soloRecruiter  = Subject{UserID: 10, Role: domain.RoleRecruiter, Memberships: map[uint]domain.OrganizationRole{}}
+	otherSolo      = Subject{UserID: 11, Role: domain.RoleRecruiter, Memberships: map[uint]domain.OrganizationRole{}}
+	orgRecruiter   = Subject{UserID: 20, Role: domain.RoleRecruiter, Memberships: map[uint]domain.OrganizationRole{orgID: domain.OrgRoleRecruiter}}
+	orgOwner       = Subject{UserID: 21, Role: domain.RoleRecruiter, Memberships: map[uint]domain.OrganizationRole{orgID: domain.OrgRoleOwner}}
+	hiringManager  = Subject{UserID: 22, Role: domain.RoleRecruiter, Memberships: map[uint]domain.OrganizationRole{orgID: domain.OrgRoleHiringManager}}
+	foreignOwner   = Subject{UserID: 30, Role: domain.RoleRecruiter, Memberships: map[uint]domain.OrganizationRole{otherOrgID: domain.OrgRoleOwner}}
+	admin          = Subject{UserID: 40, Role: domain.RoleAdmin}
+	anonymous      = Subject{}
+)
+
+func fixtures() (soloJob, orgJob *domain.Job, soloApp, orgApp *domain.Application, org *domain.Organization) {
+	id := orgID
+	soloJob = &domain.Job{ID: 1, RecruiterID: soloRecruiter.UserID}
+	orgJob = &domain.Job{ID: 2, RecruiterID: orgRecruiter.UserID, OrganizationID: &id}
+	soloApp = &domain.Application{ID: 1, JobID: soloJob.ID, Job: *soloJob, CandidateID: candidate.UserID}
+	orgApp = &domain.Application{ID: 2, JobID: orgJob.ID, Job: *orgJob, CandidateID: candidate.UserID}
+	org = &domain.Organization{ID: orgID}
+	return
+}
+
+type authzCase struct {
+	action   Action
+	subject  Subject
+	resource any
+	allowed  bool
+}
+
+func authzCases() []authzCase {
+	soloJob, orgJob, soloApp, orgApp, org := fixtures()
+	foreignOrg := &domain.Organization{ID: otherOrgID}
+	approval := &domain.JobApproval{JobID: orgJob.ID, OrganizationID: orgID, RequestedByID: orgRecruiter.UserID}
+	ownApproval := &domain.JobApproval{JobID: orgJob.ID, OrganizationID: orgID, RequestedByID: orgOwner.UserID}
+	note := &domain.ApplicationNote{ApplicationID: orgApp.ID, Application: *orgApp, AuthorID: orgRecruiter.UserID}
+
+	return []authzCase{
+		{ActionJobCreate, soloRecruiter, nil, true},
+		{ActionJobCreate, orgRecruiter, org, true},
+		{ActionJobCreate, foreignOwner, org, false},
+		{ActionJobCreate, candidate, nil, false},
+		{ActionJobCreate, anonymous, nil, false},
+		{ActionJobListMine, soloRecruiter, nil, true},
+		{ActionJobListMine, candidate, nil, false},
+		{ActionJobUpdate, soloRecruiter, soloJob, true},
+		{ActionJobUpdate, hiringManager, orgJob, true},
+		{ActionJobUpdate, otherSolo, soloJob, false},
+		{ActionJobUpdate, foreignOwner, orgJob, false},
+		{ActionJobPublish, orgRecruiter, orgJob, true},
+		{ActionJobPublish, foreignOwner, orgJob, false},
+		{ActionJobSubmit, orgRecruiter, orgJob, true},
+		{ActionJobSubmit, foreignOwner, orgJob, false},
+		{ActionJobApprove, orgOwner, approval, true},
+		{ActionJobApprove, hiringManager, approval, true},
+		{ActionJobApprove, orgRecruiter, approval, false},
+		{ActionJobApprove, orgOwner, ownApproval, false},
+		{ActionJobApprove, foreignOwner, approval, false},
+		{ActionJobViewApprovals, orgRecruiter, orgJob, true},
+		{ActionJobViewApprovals, foreignOwner, orgJob, false},
+		{ActionJobListApprovals, orgOwner, nil, true},
+		{ActionJobListApprovals, candidate, nil, false},
+		{ActionJobFinalize, soloRecruiter, soloJob, true},
+		{ActionJobFinalize, otherSolo, soloJob, false},
+		{ActionJobFinalize, foreignOwner, orgJob, false},
+		{ActionJobHire, orgRecruiter, orgJob, true},
+		{ActionJobHire, foreignOwner, orgJob, false},
+		{ActionJobReopen, soloRecruiter, soloJob, true},
+		{ActionJobReopen, otherSolo, soloJob, false},
+		{ActionJobArchive, orgOwner, orgJob, true},
+		{ActionJobArchive, foreignOwner, orgJob, false},
+		{ActionJobArchive, soloRecruiter, soloJob, true},
+		{ActionJobArchive, otherSolo, soloJob, false},
+		{ActionJobDelete, soloRecruiter, soloJob, true},
+		{ActionJobDelete, otherSolo, soloJob, false},
+		{ActionJobDelete, admin, soloJob, false},
+		{ActionJobDelete, orgRecruiter, orgJob, true},
+		{ActionJobDelete, foreignOwner, orgJob, false},
+		{ActionJobViewApplications, soloRecruiter, soloJob, true},
+		{ActionJobViewApplications, orgRecruiter, orgJob, true},
+		{ActionJobViewApplications, otherSolo, soloJob, false},
+		{ActionJobViewApplications, foreignOwner, orgJob, false},
+		{ActionJobViewApplications, candidate, soloJob, false},
+		{ActionJobManageSlots, orgRecruiter, orgJob, true},
+		{ActionJobManageSlots, foreignOwner, orgJob, false},
+		{ActionJobManageSlots, soloRecruiter, soloJob, true},
+		{ActionJobManageSlots, otherSolo, soloJob, false},
+		{ActionJobManagePipeline, orgRecruiter, orgJob, true},
+		{ActionJobManagePipeline, foreignOwner, orgJob, false},
+		{ActionApplicationMove, orgRecruiter, orgApp, true},
+		{ActionApplicationMove, soloRecruiter, soloApp, true},
+		{ActionApplicationMove, foreignOwner, orgApp, false},
+		{ActionApplicationMove, otherSolo, soloApp, false},
+		{ActionApplicationMove, candidate, orgApp, false},
+		{ActionApplicationTimeline, candidate, orgApp, true},
+		{ActionApplicationTimeline, orgRecruiter, orgApp, true},
+		{ActionApplicationTimeline, otherCandidate, orgApp, false},
+		{ActionApplicationTimeline, foreignOwner, orgApp, false},
+		{ActionApplicationNotes, orgRecruiter, orgApp, true},
+		{ActionApplicationNotes, candidate, orgApp, false},
+		{ActionApplicationNotes, foreignOwner, orgApp, false},
+		{ActionApplicationNotes, hiringManager, orgApp, true},
+		{ActionApplicationNotes, otherSolo, soloApp, false},
+		{ActionApplicationNoteEdit, orgRecruiter, note, true},
+		{ActionApplicationNoteEdit, orgOwner, note, false},
+		{ActionApplicationNoteEdit, foreignOwner, note, false},
+		{ActionApplicationScore, orgRecruiter, orgApp, true},
+		{ActionApplicationScore, candidate, orgApp, false},
+		{ActionApplicationScore, foreignOwner, orgApp, false},
+		{ActionApplicationScore, hiringManager, orgApp, true},
+		{ActionApplicationScore, otherSolo, soloApp, false},
+		{ActionApplicationInterviews, candidate, orgApp, true},
+		{ActionApplicationInterviews, orgRecruiter, orgApp, true},
+		{ActionApplicationInterviews, otherCandidate, orgApp, false},
+		{ActionApplicationInterviews, foreignOwner, orgApp, false},
+		{ActionApplicationSchedule, orgRecruiter, orgApp, true},
+		{ActionApplicationSchedule, candidate, orgApp, false},
+		{ActionApplicationSchedule, foreignOwner, orgApp, false},
+		{ActionApplicationSchedule, soloRecruiter, soloApp, true},
+		{ActionApplicationSchedule, otherSolo, soloApp, false},
+		{ActionApplicationInterviews, candidate, soloApp, true},
+		{ActionApplicationInterviews, otherSolo, soloApp, false},
+		{ActionApplicationOffers, candidate, orgApp, true},
+		{ActionApplicationOffers, orgRecruiter, orgApp, true},
+		{ActionApplicationOffers, otherCandidate, orgApp, false},
+		{ActionApplicationOffers, foreignOwner, orgApp, false},
+		{ActionApplicationOffer, orgRecruiter, orgApp, true},
+		{ActionApplicationOffer, candidate, orgApp, false},
+		{ActionApplicationOffer, foreignOwner, orgApp, false},
+		{ActionApplicationOffer, soloRecruiter, soloApp, true},
+		{ActionApplicationOffer, otherSolo, soloApp, false},
+		{ActionApplicationOffer, orgOwner, orgApp, true},
+		{ActionOrganizationCreate, soloRecruiter, nil, true},
+		{ActionOrganizationCreate, candidate, nil, false},
+		{ActionOrganizationListMine, orgRecruiter, nil, true},
+		{ActionOrganizationListMine, candidate, nil, false},
+		{ActionOrganizationManage, orgOwner, org, true},
+		{ActionOrganizationManage, orgRecruiter, org, false},
+		{ActionOrganizationManage, foreignOwner, org, false},
+		{ActionOrganizationView, orgRecruiter, org, true},
+		{ActionOrganizationView, foreignOwner, org, false},
+		{ActionOrganizationView, foreignOwner, foreignOrg, true},
+		{ActionOrganizationManage, hiringManager, org, false},
+		{ActionOrganizationView, hiringManager, org, true},
+		{ActionOrganizationJoin, soloRecruiter, nil, true},
+		{ActionOrganizationJoin, candidate, nil, false},
+		{ActionApplicationCreate, candidate, nil, true},
+		{ActionApplicationCreate, soloRecruiter, nil, false},
+		{ActionApplicationListMine, candidate, nil, true},
+		{ActionApplicationListMine, orgRecruiter, nil, false},
+		{ActionApplicationCancel, candidate, orgApp, true},
+		{ActionApplicationCancel, otherCandidate, orgApp, false},
+		{ActionApplicationCancel, orgRecruiter, orgApp, false},
+		{ActionApplicationBook, candidate, orgApp, true},
+		{ActionApplicationBook, otherCandidate, orgApp, false},
+		{ActionApplicationBook, candidate, soloApp, true},
+		{ActionApplicationBook, soloRecruiter, soloApp, false},
+		{ActionApplicationRespond, candidate, orgApp, true},
+		{ActionApplicationRespond, otherCandidate, orgApp, false},
+		{ActionApplicationRespond, orgRecruiter, orgApp, false},
+		{ActionProfileManage, candidate, nil, true},
+		{ActionProfileManage, soloRecruiter, nil, false},
+		{ActionProfileManage, admin, nil, false},
+		{ActionCategoryManage, admin, nil, true},
+		{ActionCategoryManage, orgOwner, nil, false},
+		{ActionCategoryManage, candidate, nil, false},
+		{ActionCategoryManage, soloRecruiter, nil, false},
+		{ActionDashboardView, candidate, nil, true},
+		{ActionDashboardView, soloRecruiter, nil, true},
+		{ActionDashboardView, admin, nil, false},
+		{ActionDashboardView, anonymous, nil, false},
+	}
+}
+
+func TestCan(t *testing.T) {
+	for _, tc := range authzCases() {
+		got := Can(tc.subject, tc.action, tc.resource)
+		if got != tc.allowed {
+			t.Errorf("%s as user %d (%s, %v) on %T: got allowed=%v, want %v",
+				tc.action, tc.subject.UserID, tc.subject.Role, tc.subject.Memberships, tc.resource, got, tc.allowed)
+		}
+	}
+}
+
+func TestAuthorizeWrapsErrForbidden(t *testing.T) {
+	_, orgJob, _, _, _ := fixtures()
+	err := Authorize(foreignOwner, ActionJobFinalize, orgJob)
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("got %v, want an error wrapping ErrForbidden", err)
+	}
+	if err := Authorize(orgRecruiter, ActionJobFinalize, orgJob); err != nil {
+		t.Fatalf("got %v, want nil", err)
+	}
+}
+
+type membershipRepo struct {
+	domain.OrganizationRepository
+	memberships []domain.OrganizationMember
+	calls       int
+}
+
+func (r *membershipRepo) FindByUserID(userID uint) ([]domain.OrganizationMember, error) {
+	r.calls++
+	return r.memberships, nil
+}
+
+func TestPolicyLoadsMemberships(t *testing.T) {
+	_, orgJob, _, _, _ := fixtures()
+	tests := []struct {
+		name        string
+		memberships []domain.OrganizationMember
+		allowed     bool
+	}{
+		{"member of the job's organization", []domain.OrganizationMember{{OrganizationID: orgID, Role: domain.OrgRoleRecruiter}}, true},
+		{"member of another organization", []domain.OrganizationMember{{OrganizationID: otherOrgID, Role: domain.OrgRoleOwner}}, false},
+		{"member of no organization", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &membershipRepo{memberships: tt.memberships}
+			subject := Subject{UserID: 50, Role: domain.RoleRecruiter}
+
+			err := NewPolicy(repo).Authorize(subject, ActionJobViewApplications, orgJob)
+			if (err == nil) != tt.allowed {
+				t.Fatalf("got %v, want allowed=%v", err, tt.allowed)
+			}
+			if repo.calls != 1 {
+				t.Fatalf("memberships loaded %d times, want 1", repo.calls)
+			}
+		})
+	}
+}
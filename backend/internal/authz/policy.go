@@ -0,0 +1,31 @@
+package authz
+
+import (
+	"github.com/helberthlucas14/internal/domain"
+)
+
+// Policy loads the organization memberships a decision depends on and then
+// delegates to Can. Use cases hold a Policy instead of checking roles or
+// ownership themselves.
+type Policy struct {
+	orgRepo domain.OrganizationRepository
+}
+
+func NewPolicy(orgRepo domain.OrganizationRepository) *Policy {
+	return &Policy{orgRepo: orgRepo}
+}
+
+func (p *Policy) Authorize(subject Subject, action Action, resource any) error {
+	if subject.IsRecruiter() && subject.Memberships == nil {
+		memberships, err := p.orgRepo.FindByUserID(subject.UserID)
+		if err != nil {
+			return err
+		}
+		subject.Memberships = make(map[uint]domain.OrganizationRole, len(memberships))
+		for _, m := range memberships {
+			subject.Memberships[m.OrganizationID] = m.Role
+		}
+	}
+
+	return Authorize(subject, action, resource)
+}
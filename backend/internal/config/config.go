@@ -1,8 +1,12 @@
 package config
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"log"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -16,6 +20,30 @@ type Config struct {
 	DBPassword string
 	DBName     string
 	DBSSLMode  string
+
+	AppURL       string
+	MailDriver   string
+	MailFrom     string
+	MailDir      string
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUser     string
+	SMTPPassword string
+
+	StorageDriver     string
+	StorageDir        string
+	StorageSigningKey string
+	PublicAPIURL      string
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKey       string
+	S3SecretKey       string
+	S3PathStyle       bool
+
+	GeocoderDriver string
+
+	SchedulerInterval time.Duration
 }
 
 func LoadConfig() *Config {
@@ -24,15 +52,42 @@ func LoadConfig() *Config {
 		log.Println("No .env file found, using default/env values")
 	}
 
+	port := getEnv("PORT", "8080")
+	jwtSecret := getEnv("JWT_SECRET", "secret_key_change_me")
+
 	return &Config{
-		Port:       getEnv("PORT", "8080"),
-		JWTSecret:  getEnv("JWT_SECRET", "secret_key_change_me"),
+		Port:       port,
+		JWTSecret:  jwtSecret,
 		DBHost:     getEnv("DB_HOST", "localhost"),
 		DBPort:     getEnv("DB_PORT", "5432"),
 		DBUser:     getEnv("DB_USER", "user"),
 		DBPassword: getEnv("DB_PASSWORD", "password"),
 		DBName:     getEnv("DB_NAME", "recruitment"),
 		DBSSLMode:  getEnv("DB_SSLMODE", "disable"),
+
+		AppURL:       getEnv("APP_URL", "http://localhost:5173"),
+		MailDriver:   getEnv("MAIL_DRIVER", "file"),
+		MailFrom:     getEnv("MAIL_FROM", "no-reply@recruitment.local"),
+		MailDir:      getEnv("MAIL_DIR", "mail-out"),
+		SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+		SMTPPort:     getEnv("SMTP_PORT", "1025"),
+		SMTPUser:     getEnv("SMTP_USER", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+
+		StorageDriver:     getEnv("STORAGE_DRIVER", "local"),
+		StorageDir:        getEnv("STORAGE_DIR", "uploads"),
+		StorageSigningKey: getEnv("STORAGE_SIGNING_KEY", deriveKey(jwtSecret, "storage-url")),
+		PublicAPIURL:      getEnv("PUBLIC_API_URL", "http://localhost:"+port),
+		S3Endpoint:        getEnv("S3_ENDPOINT", "https://s3.amazonaws.com"),
+		S3Region:          getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:          getEnv("S3_BUCKET", ""),
+		S3AccessKey:       getEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey:       getEnv("S3_SECRET_KEY", ""),
+		S3PathStyle:       getEnv("S3_PATH_STYLE", "true") == "true",
+
+		GeocoderDriver: getEnv("GEOCODER_DRIVER", "gazetteer"),
+
+		SchedulerInterval: getDuration("SCHEDULER_INTERVAL", time.Minute),
 	}
 }
 
@@ -42,3 +97,24 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// deriveKey derives a key for purpose from secret, so that a signature made
+// with one key is never valid under the other.
+func deriveKey(secret, purpose string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func getDuration(key string, fallback time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Printf("Invalid %s %q, using %s", key, value, fallback)
+		return fallback
+	}
+	return d
+}
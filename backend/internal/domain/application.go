@@ -16,13 +16,65 @@ const (
 )
 
 type Application struct {
-	ID          uint              `gorm:"primaryKey" json:"id"`
-	JobID       uint              `gorm:"not null" json:"job_id"`
-	Job         Job               `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"job"`
-	CandidateID uint              `gorm:"not null" json:"candidate_id"`
-	Candidate   User              `gorm:"foreignKey:CandidateID" json:"candidate"`
-	Status      ApplicationStatus `gorm:"default:'PENDING'" json:"status"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt    `gorm:"index" json:"-"`
+	ID               uint                    `gorm:"primaryKey" json:"id"`
+	JobID            uint                    `gorm:"not null" json:"job_id"`
+	Job              Job                     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"job"`
+	CandidateID      uint                    `gorm:"not null" json:"candidate_id"`
+	Candidate        User                    `gorm:"foreignKey:CandidateID" json:"candidate"`
+	Status           ApplicationStatus       `gorm:"default:'PENDING'" json:"status"`
+	StageID          *uint                   `gorm:"index" json:"stage_id"`
+	Stage            *PipelineStage          `gorm:"foreignKey:StageID" json:"stage,omitempty"`
+	StageChangedAt   *time.Time              `json:"stage_changed_at"`
+	StageChangedByID *uint                   `json:"stage_changed_by_id"`
+	Flagged          bool                    `gorm:"default:false;index" json:"flagged"`
+	Attachments      []ApplicationAttachment `gorm:"foreignKey:ApplicationID" json:"attachments,omitempty"`
+	Answers          []ScreeningAnswer       `gorm:"foreignKey:ApplicationID" json:"answers,omitempty"`
+	CreatedAt        time.Time               `json:"created_at"`
+	UpdatedAt        time.Time               `json:"updated_at"`
+	DeletedAt        gorm.DeletedAt          `gorm:"index" json:"-"`
+}
+
+type AttachmentKind string
+
+const (
+	AttachmentResume      AttachmentKind = "RESUME"
+	AttachmentCoverLetter AttachmentKind = "COVER_LETTER"
+)
+
+type ApplicationAttachment struct {
+	ID            uint           `gorm:"primaryKey" json:"id"`
+	ApplicationID uint           `gorm:"not null;index" json:"application_id"`
+	Kind          AttachmentKind `gorm:"not null" json:"kind"`
+	Filename      string         `gorm:"not null" json:"filename"`
+	ContentType   string         `gorm:"not null" json:"content_type"`
+	Size          int64          `gorm:"not null" json:"size"`
+	StorageKey    string         `gorm:"not null" json:"-"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+type ApplicationEventType string
+
+const (
+	EventApplied      ApplicationEventType = "APPLIED"
+	EventStageChanged ApplicationEventType = "STAGE_CHANGED"
+	EventRejected     ApplicationEventType = "REJECTED"
+	EventHired        ApplicationEventType = "HIRED"
+	EventCanceled     ApplicationEventType = "CANCELED"
+	EventRestored     ApplicationEventType = "RESTORED"
+)
+
+// ApplicationEvent is an append-only record of one application transition.
+// Stage names are copied so the timeline survives pipeline edits.
+type ApplicationEvent struct {
+	ID            uint                 `gorm:"primaryKey" json:"id"`
+	ApplicationID uint                 `gorm:"not null;index" json:"application_id"`
+	Type          ApplicationEventType `gorm:"not null" json:"type"`
+	ActorID       *uint                `json:"actor_id"`
+	Actor         *User                `gorm:"foreignKey:ActorID" json:"-"`
+	FromStatus    ApplicationStatus    `json:"from_status"`
+	ToStatus      ApplicationStatus    `gorm:"not null" json:"to_status"`
+	FromStage     string               `json:"from_stage"`
+	ToStage       string               `json:"to_stage"`
+	Reason        string               `json:"reason"`
+	CreatedAt     time.Time            `gorm:"index" json:"created_at"`
 }
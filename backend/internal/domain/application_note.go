@@ -0,0 +1,50 @@
+package domain
+
+import "time"
+
+// ApplicationNote is a recruiter's comment on an application. Notes belong to
+// the hiring team and are never shown to the candidate. Replies point at the
+// first note of their thread through ParentID, so threads are one level deep.
+// A private thread is only visible to the recruiters who wrote in it or were
+// mentioned in it.
+type ApplicationNote struct {
+	ID            uint           `gorm:"primaryKey" json:"id"`
+	ApplicationID uint           `gorm:"not null;index" json:"application_id"`
+	Application   Application    `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	ParentID      *uint          `gorm:"index" json:"parent_id"`
+	AuthorID      uint           `gorm:"not null" json:"author_id"`
+	Author        User           `gorm:"foreignKey:AuthorID" json:"-"`
+	Body          string         `gorm:"type:text;not null" json:"body"`
+	Private       bool           `gorm:"default:false" json:"private"`
+	Mentions      []NoteMention  `gorm:"foreignKey:NoteID" json:"mentions,omitempty"`
+	Revisions     []NoteRevision `gorm:"foreignKey:NoteID" json:"revisions,omitempty"`
+	EditedAt      *time.Time     `json:"edited_at"`
+	CreatedAt     time.Time      `gorm:"index" json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// Mentioned reports whether the note mentions userID.
+func (n *ApplicationNote) Mentioned(userID uint) bool {
+	for _, m := range n.Mentions {
+		if m.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type NoteMention struct {
+	ID     uint `gorm:"primaryKey" json:"id"`
+	NoteID uint `gorm:"not null;uniqueIndex:idx_note_mention" json:"note_id"`
+	UserID uint `gorm:"not null;uniqueIndex:idx_note_mention;index" json:"user_id"`
+	User   User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// NoteRevision keeps the text a note had before one of its edits.
+type NoteRevision struct {
+	ID         uint      `gorm:"primaryKey" json:"id"`
+	NoteID     uint      `gorm:"not null;index" json:"note_id"`
+	Body       string    `gorm:"type:text;not null" json:"body"`
+	EditedByID uint      `gorm:"not null" json:"edited_by_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
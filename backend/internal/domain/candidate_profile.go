@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrProfileNotFound is returned by CandidateProfileRepository when the user
+// has no profile.
+var ErrProfileNotFound = errors.New("profile not found")
+
+type ProfileLink struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+type CandidateProfile struct {
+	ID              uint             `gorm:"primaryKey" json:"id"`
+	UserID          uint             `gorm:"not null;uniqueIndex" json:"user_id"`
+	User            User             `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;" json:"-"`
+	Headline        string           `json:"headline"`
+	Location        string           `json:"location"`
+	DesiredSalary   *int             `json:"desired_salary"`
+	DesiredCurrency string           `gorm:"size:3" json:"desired_currency"`
+	Skills          []string         `gorm:"serializer:json;type:jsonb" json:"skills"`
+	Links           []ProfileLink    `gorm:"serializer:json;type:jsonb" json:"links"`
+	Experiences     []WorkExperience `gorm:"foreignKey:ProfileID;constraint:OnDelete:CASCADE;" json:"experiences"`
+	Education       []Education      `gorm:"foreignKey:ProfileID;constraint:OnDelete:CASCADE;" json:"education"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+// Dates on experience and education entries have month precision and are
+// stored as YYYY-MM; an empty EndDate means the entry is ongoing.
+type WorkExperience struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	ProfileID   uint   `gorm:"not null;index" json:"profile_id"`
+	Position    int    `gorm:"not null" json:"position"`
+	Title       string `gorm:"not null" json:"title"`
+	Company     string `gorm:"not null" json:"company"`
+	Location    string `json:"location"`
+	StartDate   string `gorm:"size:7;not null" json:"start_date"`
+	EndDate     string `gorm:"size:7" json:"end_date"`
+	Description string `json:"description"`
+}
+
+type Education struct {
+	ID           uint   `gorm:"primaryKey" json:"id"`
+	ProfileID    uint   `gorm:"not null;index" json:"profile_id"`
+	Position     int    `gorm:"not null" json:"position"`
+	Institution  string `gorm:"not null" json:"institution"`
+	Degree       string `gorm:"not null" json:"degree"`
+	FieldOfStudy string `json:"field_of_study"`
+	StartDate    string `gorm:"size:7;not null" json:"start_date"`
+	EndDate      string `gorm:"size:7" json:"end_date"`
+}
+
+// CurrentExperience returns the most recent ongoing job, or the latest one
+// when none is ongoing.
+func (p *CandidateProfile) CurrentExperience() *WorkExperience {
+	var latest *WorkExperience
+	for i := range p.Experiences {
+		e := &p.Experiences[i]
+		if e.EndDate == "" {
+			if latest == nil || latest.EndDate != "" || e.StartDate > latest.StartDate {
+				latest = e
+			}
+			continue
+		}
+		if latest == nil || (latest.EndDate != "" && e.EndDate > latest.EndDate) {
+			latest = e
+		}
+	}
+	return latest
+}
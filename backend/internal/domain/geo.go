@@ -0,0 +1,15 @@
+package domain
+
+// EarthRadiusKm is the mean Earth radius used for distance calculations.
+const EarthRadiusKm = 6371.0
+
+type GeoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+// Geocoder resolves free-text locations such as "Belo Horizonte - MG" to
+// coordinates. ok is false when the location is unknown (e.g. "Remoto").
+type Geocoder interface {
+	Geocode(location string) (point GeoPoint, ok bool, err error)
+}
@@ -0,0 +1,27 @@
+package domain
+
+// Hiring is a hire that JobRepository.Hire makes in one transaction with the
+// job row locked. The application moves to HiredStage. When the job is filled,
+// or Finalize is set, the job is closed, its pending applications move to
+// RejectedStage with RejectReason and its open offers are withdrawn. Offer is
+// the offer the hire accepts, if any; otherwise the hired application's open
+// offers are withdrawn. Without an application the job is only closed if it is
+// filled.
+type Hiring struct {
+	JobID         uint
+	Application   *Application
+	Offer         *Offer
+	ActorID       uint
+	HiredStage    *PipelineStage
+	RejectedStage *PipelineStage
+	RejectReason  string
+	Finalize      bool
+}
+
+// HiringResult is the state of the job after a hire.
+type HiringResult struct {
+	Status   string
+	Openings int
+	Hired    int
+	Closed   bool
+}
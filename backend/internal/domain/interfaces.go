@@ -1,27 +1,150 @@
 package domain
 
+import "time"
+
 type UserRepository interface {
 	Create(user *User) error
+	Update(user *User) error
 	FindByEmail(email string) (*User, error)
 	FindByID(id uint) (*User, error)
 }
 
+type RefreshTokenRepository interface {
+	Create(token *RefreshToken) error
+	FindByHash(hash string) (*RefreshToken, error)
+	Revoke(id uint) (bool, error)
+	RevokeSession(sessionID string) error
+	RevokeAllForUser(userID uint) error
+	IsSessionActive(sessionID string) (bool, error)
+}
+
+type UserTokenRepository interface {
+	Create(token *UserToken) error
+	FindByHash(hash string, purpose TokenPurpose) (*UserToken, error)
+	MarkUsed(id uint) (bool, error)
+	InvalidateForUser(userID uint, purpose TokenPurpose) error
+}
+
 type JobRepository interface {
 	Create(job *Job) error
 	Update(job *Job) error
-	FindAll(page, limit int, query string, status string) ([]Job, int64, error)
+	Edit(edit *JobEdit) error
+	Reopen(reopening *JobReopening) error
+	FindAll(page, limit int, filter JobFilter) ([]Job, int64, error)
+	Facets(filter JobFilter) (*JobFacets, error)
 	FindByID(id uint) (*Job, error)
-	FindByRecruiterID(recruiterID uint, page, limit int, query string, status string) ([]Job, int64, error)
+	FindDeletedByID(id uint) (*Job, error)
+	Delete(job *Job) error
+	Restore(job *Job) error
+	FindByRecruiterID(recruiterID uint, page, limit int, filter JobFilter) ([]Job, int64, error)
+	ReplaceCriteria(jobID uint, criteria []ScorecardCriterion) error
+	PublishDue(now time.Time) (int64, error)
+	ExpireDue(now time.Time) (int64, error)
+	Hire(hiring *Hiring) (*HiringResult, error)
+}
+
+type CategoryRepository interface {
+	Create(category *Category) error
+	Update(category *Category) error
+	Delete(id uint) error
+	FindAll() ([]Category, error)
+	FindByID(id uint) (*Category, error)
+	CountJobs(id uint) (int64, error)
 }
 
 type ApplicationRepository interface {
 	Create(app *Application) error
 	Update(app *Application) error
+	CreateWithEvents(app *Application, events ...*ApplicationEvent) error
+	UpdateWithEvent(app *Application, event *ApplicationEvent) error
+	FindEvents(appID uint) ([]ApplicationEvent, error)
 	FindByCandidateID(candidateID uint, page, limit int) ([]Application, int64, error)
 	FindByJobID(jobID uint) ([]Application, error)
-	FindPaginatedByJobID(jobID uint, page, limit int, status string) ([]Application, int64, error)
+	FindPaginatedByJobID(jobID uint, page, limit int, filter ApplicationFilter) ([]Application, int64, error)
 	Exists(jobID, candidateID uint) (bool, error)
 	FindByID(id uint) (*Application, error)
 	GetStats(candidateID uint) (int64, error)
 	GetPendingCount(candidateID uint) (int64, error)
 }
+
+type ApplicationNoteRepository interface {
+	Create(note *ApplicationNote) error
+	UpdateWithRevision(note *ApplicationNote, revision *NoteRevision) error
+	FindByID(id uint) (*ApplicationNote, error)
+	FindByApplicationIDs(appIDs []uint) ([]ApplicationNote, error)
+}
+
+type ScorecardRepository interface {
+	Save(scorecard *Scorecard) error
+	FindByApplicationIDs(appIDs []uint) ([]Scorecard, error)
+}
+
+type InterviewRepository interface {
+	Create(interview *Interview) error
+	Update(interview *Interview) error
+	Cancel(interview *Interview) error
+	FindByID(id uint) (*Interview, error)
+	FindByApplicationID(appID uint) ([]Interview, error)
+}
+
+type InterviewSlotRepository interface {
+	CreateMany(slots []InterviewSlot) error
+	Delete(slot *InterviewSlot) error
+	Book(slot *InterviewSlot, interview *Interview) error
+	FindByID(id uint) (*InterviewSlot, error)
+	FindUpcomingByJobID(jobID uint, from time.Time) ([]InterviewSlot, error)
+	CountOverlapping(interviewerID uint, startsAt, endsAt time.Time) (int64, error)
+}
+
+type OrganizationRepository interface {
+	CreateWithOwner(org *Organization, ownerID uint) error
+	FindByID(id uint) (*Organization, error)
+	FindByUserID(userID uint) ([]OrganizationMember, error)
+	FindMember(orgID, userID uint) (*OrganizationMember, error)
+	FindMembers(orgID uint) ([]OrganizationMember, error)
+	RemoveMember(orgID, userID uint) error
+	CountOwners(orgID uint) (int64, error)
+	CreateInvitation(invitation *OrganizationInvitation) error
+	UpdateInvitation(invitation *OrganizationInvitation) error
+	AcceptInvitation(invitation *OrganizationInvitation, userID uint) error
+	FindInvitationByID(id uint) (*OrganizationInvitation, error)
+	FindInvitationByHash(hash string) (*OrganizationInvitation, error)
+	FindPendingInvitations(orgID uint) ([]OrganizationInvitation, error)
+	Update(org *Organization) error
+	UpdateMember(member *OrganizationMember) error
+}
+
+type OfferRepository interface {
+	Create(offer *Offer) error
+	Update(offer *Offer) error
+	Respond(offer *Offer) error
+	FindByID(id uint) (*Offer, error)
+	FindByApplicationID(appID uint) ([]Offer, error)
+	Expire(offer *Offer, now time.Time) error
+	ExpireDue(now time.Time) (int64, error)
+}
+
+type JobApprovalRepository interface {
+	CreateWithJob(approval *JobApproval, job *Job, from string) error
+	UpdateWithJob(approval *JobApproval, job *Job) error
+	FindPendingByJobID(jobID uint) (*JobApproval, error)
+	FindByJobID(jobID uint) ([]JobApproval, error)
+	FindByOrganizationIDs(orgIDs []uint, status ApprovalStatus, page, limit int) ([]JobApproval, int64, error)
+}
+
+type PipelineRepository interface {
+	FindByJobID(jobID uint) ([]PipelineStage, error)
+	FindByOrganizationID(orgID uint) ([]PipelineStage, error)
+	FindByID(id uint) (*PipelineStage, error)
+	CreateDefaultStages(orgID uint, stages []PipelineStage) ([]PipelineStage, error)
+	SaveStages(stages []PipelineStage, removeIDs []uint) error
+	CreateJobStages(jobID uint, stages []PipelineStage, moves []StageMove) error
+}
+
+type CandidateProfileRepository interface {
+	Create(profile *CandidateProfile) error
+	Replace(profile *CandidateProfile) error
+	Delete(profile *CandidateProfile) error
+	FindByUserID(userID uint) (*CandidateProfile, error)
+	FindByUserIDs(userIDs []uint) ([]CandidateProfile, error)
+}
@@ -0,0 +1,79 @@
+package domain
+
+import "time"
+
+type InterviewStatus string
+
+const (
+	InterviewScheduled InterviewStatus = "SCHEDULED"
+	InterviewDone      InterviewStatus = "DONE"
+	InterviewNoShow    InterviewStatus = "NO_SHOW"
+	InterviewCanceled  InterviewStatus = "CANCELED"
+)
+
+// Interview is a meeting with the candidate of an application. UID identifies
+// its calendar event for the whole of its life: reschedules and the
+// cancellation are sent as updates of the same event, each with a higher
+// Sequence, as RFC 5545 requires.
+type Interview struct {
+	ID            uint                   `gorm:"primaryKey" json:"id"`
+	ApplicationID uint                   `gorm:"not null;index" json:"application_id"`
+	Application   Application            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	UID           string                 `gorm:"not null;uniqueIndex" json:"uid"`
+	Sequence      int                    `gorm:"not null;default:0" json:"sequence"`
+	StartsAt      time.Time              `gorm:"not null;index" json:"starts_at"`
+	EndsAt        time.Time              `gorm:"not null" json:"ends_at"`
+	Location      string                 `json:"location"`
+	VideoURL      string                 `json:"video_url"`
+	Status        InterviewStatus        `gorm:"not null;default:'SCHEDULED';index" json:"status"`
+	Interviewers  []InterviewParticipant `gorm:"foreignKey:InterviewID" json:"interviewers"`
+	ScheduledByID uint                   `gorm:"not null" json:"scheduled_by_id"`
+	ScheduledBy   User                   `gorm:"foreignKey:ScheduledByID" json:"-"`
+	CancelReason  string                 `json:"cancel_reason"`
+	CreatedAt     time.Time              `json:"created_at"`
+	UpdatedAt     time.Time              `json:"updated_at"`
+}
+
+// Active reports whether the interview is still ahead and so may be
+// rescheduled or canceled.
+func (i *Interview) Active() bool {
+	return i.Status == InterviewScheduled
+}
+
+// InterviewParticipant is a recruiter interviewing the candidate.
+type InterviewParticipant struct {
+	ID          uint `gorm:"primaryKey" json:"id"`
+	InterviewID uint `gorm:"not null;uniqueIndex:idx_interview_participant" json:"interview_id"`
+	UserID      uint `gorm:"not null;uniqueIndex:idx_interview_participant;index" json:"user_id"`
+	User        User `gorm:"foreignKey:UserID" json:"-"`
+}
+
+// InterviewSlot is a period in which an interviewer is available to interview
+// candidates of a job. Candidates whose application is in Stage, or any
+// pending candidate when Stage is nil, may book it; booking links the slot to
+// the Interview it creates, and canceling or moving that interview frees it.
+type InterviewSlot struct {
+	ID            uint           `gorm:"primaryKey" json:"id"`
+	JobID         uint           `gorm:"not null;index" json:"job_id"`
+	Job           Job            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	StageID       *uint          `gorm:"index" json:"stage_id"`
+	Stage         *PipelineStage `gorm:"foreignKey:StageID" json:"-"`
+	InterviewerID uint           `gorm:"not null;index" json:"interviewer_id"`
+	Interviewer   User           `gorm:"foreignKey:InterviewerID" json:"-"`
+	StartsAt      time.Time      `gorm:"not null;index" json:"starts_at"`
+	EndsAt        time.Time      `gorm:"not null" json:"ends_at"`
+	Location      string         `json:"location"`
+	VideoURL      string         `json:"video_url"`
+	InterviewID   *uint          `gorm:"uniqueIndex" json:"interview_id"`
+	Interview     *Interview     `gorm:"foreignKey:InterviewID;constraint:OnDelete:SET NULL;" json:"-"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+func (s *InterviewSlot) Booked() bool {
+	return s.InterviewID != nil
+}
+
+// OpenTo reports whether an application in stageID may book the slot.
+func (s *InterviewSlot) OpenTo(stageID *uint) bool {
+	return s.StageID == nil || (stageID != nil && *s.StageID == *stageID)
+}
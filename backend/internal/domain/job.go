@@ -1,24 +1,210 @@
 package domain
 
 import (
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// Job statuses. DRAFT jobs are being written, PENDING_APPROVAL jobs wait for
+// an approver and SCHEDULED jobs wait for their PublishAt, none is public yet;
+// EXPIRED jobs passed their ExpiresAt without anyone being hired.
+const (
+	JobStatusDraft           = "DRAFT"
+	JobStatusPendingApproval = "PENDING_APPROVAL"
+	JobStatusScheduled       = "SCHEDULED"
+	JobStatusOpen            = "OPEN"
+	JobStatusPaused          = "PAUSED"
+	JobStatusClosed          = "CLOSED"
+	JobStatusExpired         = "EXPIRED"
+)
+
+// UnpublishedJobStatuses are hidden from candidates.
+var UnpublishedJobStatuses = []string{JobStatusDraft, JobStatusPendingApproval, JobStatusScheduled}
+
+// jobTransitions is the job state machine: the statuses each status may move
+// to. DRAFT → OPEN ↔ PAUSED → CLOSED, with PENDING_APPROVAL, SCHEDULED and
+// EXPIRED on the side.
+var jobTransitions = map[string][]string{
+	JobStatusDraft:           {JobStatusPendingApproval, JobStatusScheduled, JobStatusOpen},
+	JobStatusPendingApproval: {JobStatusDraft, JobStatusScheduled, JobStatusOpen},
+	JobStatusScheduled:       {JobStatusOpen, JobStatusExpired},
+	JobStatusOpen:            {JobStatusPaused, JobStatusClosed, JobStatusExpired},
+	JobStatusPaused:          {JobStatusOpen, JobStatusClosed, JobStatusExpired},
+	JobStatusClosed:          {JobStatusPendingApproval, JobStatusOpen},
+	JobStatusExpired:         {JobStatusPendingApproval, JobStatusOpen},
+}
+
 type Job struct {
-	ID           uint           `gorm:"primaryKey" json:"id"`
-	Title        string         `gorm:"not null" json:"title"`
-	Description  string         `gorm:"not null" json:"description"`
-	Company      string         `gorm:"not null" json:"company"`
-	Location     string         `gorm:"not null" json:"location"`
-	Requirements string         `json:"requirements"`
-	Salary       string         `json:"salary"`
-	Status       string         `gorm:"default:'OPEN'" json:"status"`
-	RecruiterID  uint           `gorm:"default:0" json:"recruiter_id"`
-	Recruiter    User           `gorm:"foreignKey:RecruiterID" json:"-"`
-	Anonymous    bool           `gorm:"default:false" json:"anonymous"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt `gorm:"index" json:"-"`
+	ID             uint                 `gorm:"primaryKey" json:"id"`
+	Title          string               `gorm:"not null" json:"title"`
+	Description    string               `gorm:"not null" json:"description"`
+	Company        string               `gorm:"not null" json:"company"`
+	Location       string               `gorm:"not null" json:"location"`
+	Latitude       *float64             `gorm:"index" json:"latitude"`
+	Longitude      *float64             `gorm:"index" json:"longitude"`
+	Requirements   string               `json:"requirements"`
+	CategoryID     *uint                `gorm:"index" json:"category_id"`
+	Category       *Category            `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+	EmploymentType EmploymentType       `gorm:"index" json:"employment_type"`
+	Seniority      Seniority            `gorm:"index" json:"seniority"`
+	WorkModel      WorkModel            `gorm:"index" json:"work_model"`
+	SalaryMin      *int                 `gorm:"index" json:"salary_min"`
+	SalaryMax      *int                 `gorm:"index" json:"salary_max"`
+	SalaryCurrency string               `gorm:"size:3" json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod         `json:"salary_period"`
+	SalaryHidden   bool                 `gorm:"default:false" json:"salary_hidden"`
+	Salary         string               `json:"salary"`
+	Status         string               `gorm:"default:'OPEN'" json:"status"`
+	Openings       int                  `gorm:"not null;default:1" json:"openings"`
+	PublishAt      *time.Time           `gorm:"index" json:"publish_at"`
+	ExpiresAt      *time.Time           `gorm:"index" json:"expires_at"`
+	ArchivedAt     *time.Time           `gorm:"index" json:"archived_at"`
+	RecruiterID    uint                 `gorm:"default:0" json:"recruiter_id"`
+	Recruiter      User                 `gorm:"foreignKey:RecruiterID" json:"-"`
+	OrganizationID *uint                `gorm:"index" json:"organization_id"`
+	Organization   *Organization        `gorm:"foreignKey:OrganizationID" json:"-"`
+	Anonymous      bool                 `gorm:"default:false" json:"anonymous"`
+	Questions      []ScreeningQuestion  `gorm:"foreignKey:JobID" json:"questions,omitempty"`
+	Criteria       []ScorecardCriterion `gorm:"foreignKey:JobID" json:"criteria,omitempty"`
+	Match          JobSearchMatch       `gorm:"embedded" json:"-"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt       `gorm:"index" json:"-"`
+}
+
+// CanBecome reports whether the state machine lets the job move to status.
+func (j *Job) CanBecome(status string) bool {
+	for _, next := range jobTransitions[j.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Archivable reports whether the job may be archived: it must not be live or
+// on its way to being published.
+func (j *Job) Archivable() bool {
+	switch j.Status {
+	case JobStatusDraft, JobStatusClosed, JobStatusExpired:
+		return true
+	}
+	return false
+}
+
+// RequiresApproval reports whether the job's organization wants its postings
+// approved before they go live. Organization must be loaded.
+func (j *Job) RequiresApproval() bool {
+	return j.Organization != nil && j.Organization.RequireJobApproval
+}
+
+// IsPublished reports whether candidates can see the job at now.
+func (j *Job) IsPublished(now time.Time) bool {
+	for _, hidden := range UnpublishedJobStatuses {
+		if j.Status == hidden {
+			return false
+		}
+	}
+	return j.PublishAt == nil || !j.PublishAt.After(now)
+}
+
+// MissingFields lists the fields a job needs before it can be published.
+func (j *Job) MissingFields() []string {
+	var missing []string
+	for _, f := range []struct{ name, value string }{
+		{"title", j.Title},
+		{"description", j.Description},
+		{"company", j.Company},
+		{"location", j.Location},
+	} {
+		if strings.TrimSpace(f.value) == "" {
+			missing = append(missing, f.name)
+		}
+	}
+	return missing
+}
+
+// AcceptsApplications reports whether candidates can apply at now. It does
+// not wait for the scheduler to notice an expiration.
+func (j *Job) AcceptsApplications(now time.Time) bool {
+	return j.Status == JobStatusOpen && j.IsPublished(now) && (j.ExpiresAt == nil || j.ExpiresAt.After(now))
+}
+
+// JobSearchMatch is how a job matched a full-text query or a distance search.
+// It is read from computed columns of search queries and never stored.
+type JobSearchMatch struct {
+	SearchRank           float64  `gorm:"->;-:migration"`
+	TitleHighlight       string   `gorm:"->;-:migration"`
+	DescriptionHighlight string   `gorm:"->;-:migration"`
+	DistanceKm           *float64 `gorm:"->;-:migration"`
+}
+
+// JobEdit is an edit of a job that was read in status From. Questions and
+// Criteria, when not nil, replace the job's screening questions and scorecard
+// criteria.
+type JobEdit struct {
+	Job       *Job
+	From      string
+	Questions *[]ScreeningQuestion
+	Criteria  *[]ScorecardCriterion
+}
+
+// JobReopening reopens a CLOSED job in one transaction. Job is stored with its
+// new status, Approval is recorded when the job goes back to approval, and
+// each restoration moves an application rejected by the close back to
+// PENDING together with the event describing it.
+type JobReopening struct {
+	Job          *Job
+	Approval     *JobApproval
+	Restorations []ApplicationTransition
+}
+
+// ApplicationTransition is an application with its new status and stage set,
+// and the event describing the change.
+type ApplicationTransition struct {
+	Application *Application
+	Event       *ApplicationEvent
+}
+
+// JobFilter narrows job listings. Salary bounds and SalaryBand match jobs whose
+// published range overlaps them in Currency and SalaryPeriod; jobs with hidden
+// salaries never match a salary filter. Slice filters match any of their values; Categories holds
+// category slugs. Near sorts jobs by distance from a point, and RadiusKm then
+// drops jobs farther away or without coordinates. PublishedAt keeps only jobs
+// published at that time, as candidates see them. Archived jobs are left out
+// unless Archived asks for them alone; Deleted lists only soft-deleted jobs.
+type JobFilter struct {
+	Query           string
+	Status          string
+	SalaryMin       *int
+	SalaryMax       *int
+	Currency        string
+	SalaryPeriod    SalaryPeriod
+	SalaryBand      string
+	Categories      []string
+	Locations       []string
+	Companies       []string
+	EmploymentTypes []EmploymentType
+	Seniorities     []Seniority
+	WorkModels      []WorkModel
+	PostedAfter     *time.Time
+	Near            *GeoPoint
+	RadiusKm        *float64
+	PublishedAt     *time.Time
+	Archived        bool
+	Deleted         bool
+}
+
+// ScopeSalary returns f with Currency and SalaryPeriod defaulting to BRL and
+// MONTH, since salary amounts only compare within one currency and period.
+func (f JobFilter) ScopeSalary() JobFilter {
+	if f.Currency == "" {
+		f.Currency = DefaultSalaryCurrency
+	}
+	if f.SalaryPeriod == "" {
+		f.SalaryPeriod = SalaryPerMonth
+	}
+	return f
 }
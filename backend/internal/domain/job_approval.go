@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+type ApprovalStatus string
+
+const (
+	ApprovalPending  ApprovalStatus = "PENDING"
+	ApprovalApproved ApprovalStatus = "APPROVED"
+	ApprovalRejected ApprovalStatus = "REJECTED"
+)
+
+// JobApproval is one request to publish a job in an organization that
+// requires approval, and the approver's decision on it. A job has at most one
+// PENDING approval; decided ones are kept as its approval history.
+type JobApproval struct {
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	JobID           uint           `gorm:"not null;index" json:"job_id"`
+	Job             Job            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	OrganizationID  uint           `gorm:"not null;index" json:"organization_id"`
+	RequestedByID   uint           `gorm:"not null" json:"requested_by_id"`
+	RequestedBy     User           `gorm:"foreignKey:RequestedByID" json:"-"`
+	Comment         string         `json:"comment"`
+	Status          ApprovalStatus `gorm:"not null;default:'PENDING';index" json:"status"`
+	DecidedByID     *uint          `json:"decided_by_id"`
+	DecidedBy       *User          `gorm:"foreignKey:DecidedByID" json:"-"`
+	DecisionComment string         `json:"decision_comment"`
+	DecidedAt       *time.Time     `json:"decided_at"`
+	CreatedAt       time.Time      `gorm:"index" json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+}
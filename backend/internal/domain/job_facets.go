@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// FacetCount is how many jobs share one value of a facet.
+type FacetCount struct {
+	Value string
+	Count int64
+}
+
+// JobFacets counts the jobs matching a search per facet value. Each facet is
+// counted with every filter applied except its own, so the counts show how
+// many jobs picking that value would add or keep.
+type JobFacets struct {
+	Location       []FacetCount
+	Company        []FacetCount
+	EmploymentType []FacetCount
+	Seniority      []FacetCount
+	WorkModel      []FacetCount
+	SalaryBand     []FacetCount
+	PostedWithin   []FacetCount
+}
+
+// SalaryBand is a fixed pay bracket offered as a facet. A job falls into every
+// band its published range overlaps.
+type SalaryBand struct {
+	Key string
+	Min *int
+	Max *int
+}
+
+func salaryBound(n int) *int { return &n }
+
+var SalaryBands = []SalaryBand{
+	{Key: "0-3000", Max: salaryBound(3000)},
+	{Key: "3000-5000", Min: salaryBound(3000), Max: salaryBound(5000)},
+	{Key: "5000-8000", Min: salaryBound(5000), Max: salaryBound(8000)},
+	{Key: "8000-12000", Min: salaryBound(8000), Max: salaryBound(12000)},
+	{Key: "12000+", Min: salaryBound(12000)},
+}
+
+func FindSalaryBand(key string) (SalaryBand, bool) {
+	for _, b := range SalaryBands {
+		if b.Key == key {
+			return b, true
+		}
+	}
+	return SalaryBand{}, false
+}
+
+// PostedWithin is a recency window offered as a facet.
+type PostedWithin struct {
+	Key    string
+	Window time.Duration
+}
+
+var PostedWithinOptions = []PostedWithin{
+	{Key: "24h", Window: 24 * time.Hour},
+	{Key: "7d", Window: 7 * 24 * time.Hour},
+	{Key: "30d", Window: 30 * 24 * time.Hour},
+}
+
+func FindPostedWithin(key string) (PostedWithin, bool) {
+	for _, p := range PostedWithinOptions {
+		if p.Key == key {
+			return p, true
+		}
+	}
+	return PostedWithin{}, false
+}
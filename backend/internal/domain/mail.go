@@ -0,0 +1,18 @@
+package domain
+
+type MailAttachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+type MailMessage struct {
+	To          []string
+	Subject     string
+	Body        string
+	Attachments []MailAttachment
+}
+
+type MailSender interface {
+	Send(msg MailMessage) error
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+type OfferStatus string
+
+const (
+	OfferDraft     OfferStatus = "DRAFT"
+	OfferSent      OfferStatus = "SENT"
+	OfferAccepted  OfferStatus = "ACCEPTED"
+	OfferDeclined  OfferStatus = "DECLINED"
+	OfferExpired   OfferStatus = "EXPIRED"
+	OfferWithdrawn OfferStatus = "WITHDRAWN"
+)
+
+// DefaultOfferLetterTemplate is the text/template used for the offer letters
+// of organizations without their own, and of jobs outside organizations.
+const DefaultOfferLetterTemplate = `{{.Date}}
+
+Dear {{.CandidateName}},
+
+We are pleased to offer you the position of {{.JobTitle}} at {{.Company}}.
+
+Salary: {{.Salary}}
+Start date: {{.StartDate}}
+{{if .Benefits}}
+Benefits:
+{{.Benefits}}
+{{end}}
+This offer is valid until {{.ExpiresAt}}. You can accept or decline it from your applications page.
+
+Sincerely,
+{{.SenderName}}
+{{.Company}}
+`
+
+// Offer is a job offer made to the candidate of an application. It is edited
+// as a DRAFT, then SENT to the candidate, who accepts or declines it before
+// ExpiresAt; unanswered offers become EXPIRED, and open offers are WITHDRAWN
+// when their job closes. An application has at most one DRAFT or SENT offer at
+// a time.
+type Offer struct {
+	ID             uint         `gorm:"primaryKey" json:"id"`
+	ApplicationID  uint         `gorm:"not null;index" json:"application_id"`
+	Application    Application  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	Salary         int          `gorm:"not null" json:"salary"`
+	SalaryCurrency string       `gorm:"size:3;not null" json:"salary_currency"`
+	SalaryPeriod   SalaryPeriod `gorm:"not null" json:"salary_period"`
+	StartDate      time.Time    `gorm:"not null" json:"start_date"`
+	Benefits       string       `json:"benefits"`
+	ExpiresAt      time.Time    `gorm:"not null;index" json:"expires_at"`
+	Status         OfferStatus  `gorm:"not null;default:'DRAFT';index" json:"status"`
+	CreatedByID    uint         `gorm:"not null" json:"created_by_id"`
+	CreatedBy      User         `gorm:"foreignKey:CreatedByID" json:"-"`
+	SentAt         *time.Time   `json:"sent_at"`
+	RespondedAt    *time.Time   `json:"responded_at"`
+	DeclineReason  string       `json:"decline_reason"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// Open reports whether the offer is still being prepared or awaiting an answer.
+func (o *Offer) Open() bool {
+	return o.Status == OfferDraft || o.Status == OfferSent
+}
+
+// Lapsed reports whether a sent offer reached its expiry without an answer.
+func (o *Offer) Lapsed(now time.Time) bool {
+	return o.Status == OfferSent && !now.Before(o.ExpiresAt)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type OrganizationRole string
+
+const (
+	OrgRoleOwner         OrganizationRole = "OWNER"
+	OrgRoleRecruiter     OrganizationRole = "RECRUITER"
+	OrgRoleHiringManager OrganizationRole = "HIRING_MANAGER"
+)
+
+// CanApproveJobs reports whether members with the role may approve the
+// organization's postings.
+func (r OrganizationRole) CanApproveJobs() bool {
+	return r == OrgRoleOwner || r == OrgRoleHiringManager
+}
+
+type InvitationStatus string
+
+const (
+	InvitationPending  InvitationStatus = "PENDING"
+	InvitationAccepted InvitationStatus = "ACCEPTED"
+	InvitationRevoked  InvitationStatus = "REVOKED"
+)
+
+// Organization is a hiring team. When RequireJobApproval is set, its jobs go
+// through a JobApproval before they are published. OfferLetterTemplate
+// replaces DefaultOfferLetterTemplate for its offer letters when set.
+type Organization struct {
+	ID                  uint           `gorm:"primaryKey" json:"id"`
+	Name                string         `gorm:"not null" json:"name"`
+	RequireJobApproval  bool           `gorm:"default:false" json:"require_job_approval"`
+	OfferLetterTemplate string         `gorm:"type:text" json:"offer_letter_template"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	DeletedAt           gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+type OrganizationMember struct {
+	ID             uint             `gorm:"primaryKey" json:"id"`
+	OrganizationID uint             `gorm:"not null;uniqueIndex:idx_org_member" json:"organization_id"`
+	Organization   Organization     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	UserID         uint             `gorm:"not null;uniqueIndex:idx_org_member;index" json:"user_id"`
+	User           User             `gorm:"foreignKey:UserID" json:"-"`
+	Role           OrganizationRole `gorm:"not null;default:'RECRUITER'" json:"role"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+type OrganizationInvitation struct {
+	ID             uint             `gorm:"primaryKey" json:"id"`
+	OrganizationID uint             `gorm:"not null;index" json:"organization_id"`
+	Organization   Organization     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	Email          string           `gorm:"not null;index" json:"email"`
+	InvitedByID    uint             `gorm:"not null" json:"invited_by_id"`
+	TokenHash      string           `gorm:"uniqueIndex;not null" json:"-"`
+	Status         InvitationStatus `gorm:"not null;default:'PENDING'" json:"status"`
+	ExpiresAt      time.Time        `gorm:"not null" json:"expires_at"`
+	AcceptedAt     *time.Time       `json:"accepted_at"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+)
+
+type StageKind string
+
+const (
+	StageKindActive   StageKind = "ACTIVE"
+	StageKindHired    StageKind = "HIRED"
+	StageKindRejected StageKind = "REJECTED"
+)
+
+var DefaultPipelineStages = []string{"Applied", "Screening", "Phone screen", "Technical interview", "Offer"}
+
+// PipelineStage is one step of a hiring pipeline. Stages with a JobID belong
+// to that job only; stages without one are the organization's default
+// pipeline.
+type PipelineStage struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	OrganizationID uint      `gorm:"not null;index" json:"organization_id"`
+	JobID          *uint     `gorm:"index" json:"job_id"`
+	Name           string    `gorm:"not null" json:"name"`
+	Position       int       `gorm:"not null" json:"position"`
+	Kind           StageKind `gorm:"not null;default:'ACTIVE'" json:"kind"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func (s *PipelineStage) IsTerminal() bool {
+	return s.Kind != StageKindActive
+}
+
+// StageMove carries a job's applications from one of its current stages to
+// To when the job gets its own pipeline. A nil To drops the stage, which must
+// not hold any of the job's applications.
+type StageMove struct {
+	From PipelineStage
+	To   *PipelineStage
+}
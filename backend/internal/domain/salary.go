@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type SalaryPeriod string
+
+const (
+	SalaryPerHour  SalaryPeriod = "HOUR"
+	SalaryPerMonth SalaryPeriod = "MONTH"
+	SalaryPerYear  SalaryPeriod = "YEAR"
+)
+
+const DefaultSalaryCurrency = "BRL"
+
+// currencyCodes are the ISO 4217 codes of the currencies in circulation. Fund
+// codes, precious metals and testing codes are left out, as nobody is paid in
+// them.
+var currencyCodes = map[string]bool{
+	"AED": true, "AFN": true, "ALL": true, "AMD": true, "ANG": true, "AOA": true, "ARS": true, "AUD": true, "AWG": true, "AZN": true, "BAM": true, "BBD": true,
+	"BDT": true, "BGN": true, "BHD": true, "BIF": true, "BMD": true, "BND": true, "BOB": true, "BRL": true, "BSD": true, "BTN": true, "BWP": true, "BYN": true,
+	"BZD": true, "CAD": true, "CDF": true, "CHF": true, "CLP": true, "CNY": true, "COP": true, "CRC": true, "CUC": true, "CUP": true, "CVE": true, "CZK": true,
+	"DJF": true, "DKK": true, "DOP": true, "DZD": true, "EGP": true, "ERN": true, "ETB": true, "EUR": true, "FJD": true, "FKP": true, "GBP": true, "GEL": true,
+	"GHS": true, "GIP": true, "GMD": true, "GNF": true, "GTQ": true, "GYD": true, "HKD": true, "HNL": true, "HTG": true, "HUF": true, "IDR": true, "ILS": true,
+	"INR": true, "IQD": true, "IRR": true, "ISK": true, "JMD": true, "JOD": true, "JPY": true, "KES": true, "KGS": true, "KHR": true, "KMF": true, "KPW": true,
+	"KRW": true, "KWD": true, "KYD": true, "KZT": true, "LAK": true, "LBP": true, "LKR": true, "LRD": true, "LSL": true, "LYD": true, "MAD": true, "MDL": true,
+	"MGA": true, "MKD": true, "MMK": true, "MNT": true, "MOP": true, "MRU": true, "MUR": true, "MVR": true, "MWK": true, "MXN": true, "MYR": true, "MZN": true,
+	"NAD": true, "NGN": true, "NIO": true, "NOK": true, "NPR": true, "NZD": true, "OMR": true, "PAB": true, "PEN": true, "PGK": true, "PHP": true, "PKR": true,
+	"PLN": true, "PYG": true, "QAR": true, "RON": true, "RSD": true, "RUB": true, "RWF": true, "SAR": true, "SBD": true, "SCR": true, "SDG": true, "SEK": true,
+	"SGD": true, "SHP": true, "SLE": true, "SLL": true, "SOS": true, "SRD": true, "SSP": true, "STN": true, "SVC": true, "SYP": true, "SZL": true, "THB": true,
+	"TJS": true, "TMT": true, "TND": true, "TOP": true, "TRY": true, "TTD": true, "TWD": true, "TZS": true, "UAH": true, "UGX": true, "USD": true, "UYU": true,
+	"UZS": true, "VED": true, "VES": true, "VND": true, "VUV": true, "WST": true, "XAF": true, "XCD": true, "XCG": true, "XOF": true, "XPF": true, "YER": true,
+	"ZAR": true, "ZMW": true, "ZWG": true, "ZWL": true,
+}
+
+// IsCurrencyCode reports whether code is an ISO 4217 currency code.
+func IsCurrencyCode(code string) bool {
+	return currencyCodes[code]
+}
+
+func (p SalaryPeriod) IsValid() bool {
+	return p == SalaryPerHour || p == SalaryPerMonth || p == SalaryPerYear
+}
+
+type SalaryRange struct {
+	Min      int
+	Max      int
+	Currency string
+	Period   SalaryPeriod
+}
+
+var (
+	salaryAmountPattern = regexp.MustCompile(`\d[\d.,]*\s*(?:k|mil)?`)
+	salarySymbols       = []struct{ symbol, code string }{
+		{"r$", "BRL"}, {"us$", "USD"}, {"€", "EUR"}, {"£", "GBP"}, {"$", "USD"},
+	}
+	salaryWords = map[string]string{
+		"brl": "BRL", "reais": "BRL", "usd": "USD", "eur": "EUR", "gbp": "GBP",
+	}
+	salaryPeriodWords = map[string]SalaryPeriod{
+		"h": SalaryPerHour, "hr": SalaryPerHour, "hora": SalaryPerHour, "hour": SalaryPerHour, "hourly": SalaryPerHour,
+		"mês": SalaryPerMonth, "mes": SalaryPerMonth, "mensal": SalaryPerMonth, "month": SalaryPerMonth, "monthly": SalaryPerMonth,
+		"ano": SalaryPerYear, "anual": SalaryPerYear, "year": SalaryPerYear, "yearly": SalaryPerYear, "annual": SalaryPerYear, "yr": SalaryPerYear,
+	}
+)
+
+// ParseSalary reads the free-text salaries jobs used to store, such as "8000",
+// "R$ 5.000 - 7.500", "5k-8k" or "USD 40/hour". Currency defaults to BRL and
+// period to MONTH; text with no amount or more than two amounts is rejected.
+func ParseSalary(text string) (SalaryRange, bool) {
+	lower := strings.ToLower(strings.TrimSpace(text))
+	if lower == "" {
+		return SalaryRange{}, false
+	}
+
+	r := SalaryRange{Currency: DefaultSalaryCurrency, Period: SalaryPerMonth}
+	for _, c := range salarySymbols {
+		if strings.Contains(lower, c.symbol) {
+			r.Currency = c.code
+			break
+		}
+	}
+	for _, word := range strings.FieldsFunc(lower, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		if code, ok := salaryWords[word]; ok {
+			r.Currency = code
+		}
+		if period, ok := salaryPeriodWords[word]; ok {
+			r.Period = period
+		}
+	}
+
+	matches := salaryAmountPattern.FindAllString(lower, -1)
+	if len(matches) == 0 || len(matches) > 2 {
+		return SalaryRange{}, false
+	}
+
+	amounts := make([]int, len(matches))
+	for i, m := range matches {
+		n, ok := parseAmount(m)
+		if !ok || n <= 0 {
+			return SalaryRange{}, false
+		}
+		amounts[i] = n
+	}
+
+	r.Min, r.Max = amounts[0], amounts[len(amounts)-1]
+	if r.Min > r.Max {
+		r.Min, r.Max = r.Max, r.Min
+	}
+	return r, true
+}
+
+// parseAmount understands both "8.000,50" and "8,000.50" as well as a "k" or
+// "mil" suffix. A lone separator followed by exactly three digits is read as a
+// thousands separator.
+func parseAmount(s string) (int, bool) {
+	multiplier := 1.0
+	s = strings.TrimSpace(s)
+	switch {
+	case strings.HasSuffix(s, "mil"):
+		multiplier, s = 1000, strings.TrimSpace(strings.TrimSuffix(s, "mil"))
+	case strings.HasSuffix(s, "k"):
+		multiplier, s = 1000, strings.TrimSpace(strings.TrimSuffix(s, "k"))
+	}
+	s = strings.TrimRight(s, ".,")
+
+	lastDot, lastComma := strings.LastIndex(s, "."), strings.LastIndex(s, ",")
+	decimal := -1
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		decimal = max(lastDot, lastComma)
+	case lastDot >= 0 || lastComma >= 0:
+		sep := max(lastDot, lastComma)
+		if strings.Count(s, string(s[sep])) == 1 && len(s)-sep-1 != 3 {
+			decimal = sep
+		}
+	}
+
+	var b strings.Builder
+	for i, ch := range s {
+		switch {
+		case ch >= '0' && ch <= '9':
+			b.WriteRune(ch)
+		case i == decimal:
+			b.WriteByte('.')
+		}
+	}
+
+	n, err := strconv.ParseFloat(b.String(), 64)
+	if err != nil {
+		return 0, false
+	}
+	return int(math.Round(n * multiplier)), true
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "time"
+
+const (
+	MinScore = 1
+	MaxScore = 5
+)
+
+// DefaultScorecardCriteria are given to jobs that do not define their own.
+var DefaultScorecardCriteria = []string{"Technical", "Communication", "Culture"}
+
+// ScorecardCriterion is one aspect interviewers rate candidates of a job on.
+type ScorecardCriterion struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	JobID     uint      `gorm:"not null;index" json:"job_id"`
+	Position  int       `gorm:"not null" json:"position"`
+	Name      string    `gorm:"not null" json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Scorecard is one interviewer's evaluation of an application. Each
+// interviewer has at most one per application, replaced when resubmitted.
+// Average is the mean of its ratings, stored so applications can be sorted
+// and filtered by score.
+type Scorecard struct {
+	ID            uint              `gorm:"primaryKey" json:"id"`
+	ApplicationID uint              `gorm:"not null;uniqueIndex:idx_scorecard_interviewer" json:"application_id"`
+	Application   Application       `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+	InterviewerID uint              `gorm:"not null;uniqueIndex:idx_scorecard_interviewer;index" json:"interviewer_id"`
+	Interviewer   User              `gorm:"foreignKey:InterviewerID" json:"-"`
+	Ratings       []ScorecardRating `gorm:"foreignKey:ScorecardID" json:"ratings"`
+	Average       float64           `gorm:"not null" json:"average"`
+	Comment       string            `gorm:"type:text" json:"comment"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// ScorecardRating is the score given to one criterion. The criterion name is
+// copied so scorecards survive changes to the job's criteria.
+type ScorecardRating struct {
+	ID          uint   `gorm:"primaryKey" json:"id"`
+	ScorecardID uint   `gorm:"not null;index" json:"scorecard_id"`
+	CriterionID uint   `gorm:"not null" json:"criterion_id"`
+	Criterion   string `gorm:"not null" json:"criterion"`
+	Score       int    `gorm:"not null" json:"score"`
+}
+
+type ApplicationSort string
+
+const (
+	ApplicationSortNewest    ApplicationSort = ""
+	ApplicationSortScoreDesc ApplicationSort = "score_desc"
+	ApplicationSortScoreAsc  ApplicationSort = "score_asc"
+)
+
+// ApplicationFilter narrows the applications of a job. Score bounds and score
+// sorting apply to the average of all scorecards, but only on the applications
+// ScoredBy has submitted a scorecard for, so they reveal nothing the average
+// shown to that interviewer does not; other applications are left out by score
+// bounds and sort last.
+type ApplicationFilter struct {
+	Status   string
+	MinScore *float64
+	MaxScore *float64
+	Sort     ApplicationSort
+	ScoredBy uint
+}
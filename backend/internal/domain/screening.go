@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+type QuestionType string
+
+const (
+	QuestionYesNo          QuestionType = "YES_NO"
+	QuestionNumber         QuestionType = "NUMBER"
+	QuestionSingleChoice   QuestionType = "SINGLE_CHOICE"
+	QuestionMultipleChoice QuestionType = "MULTIPLE_CHOICE"
+	QuestionText           QuestionType = "TEXT"
+)
+
+type KnockoutAction string
+
+const (
+	KnockoutReject KnockoutAction = "REJECT"
+	KnockoutFlag   KnockoutAction = "FLAG"
+)
+
+// KnockoutRule says which answers are acceptable for a question. Which field
+// applies depends on the question type: Expected for YES_NO, Min/Max for
+// NUMBER and Accepted for choice questions, where at least one chosen option
+// must be accepted.
+type KnockoutRule struct {
+	Action   KnockoutAction `json:"action"`
+	Expected *bool          `json:"expected,omitempty"`
+	Min      *float64       `json:"min,omitempty"`
+	Max      *float64       `json:"max,omitempty"`
+	Accepted []string       `json:"accepted,omitempty"`
+}
+
+type ScreeningQuestion struct {
+	ID        uint          `gorm:"primaryKey" json:"id"`
+	JobID     uint          `gorm:"not null;index" json:"job_id"`
+	Position  int           `gorm:"not null" json:"position"`
+	Prompt    string        `gorm:"not null" json:"prompt"`
+	Type      QuestionType  `gorm:"not null" json:"type"`
+	Required  bool          `gorm:"default:false" json:"required"`
+	Options   []string      `gorm:"serializer:json;type:jsonb" json:"options"`
+	Knockout  *KnockoutRule `gorm:"serializer:json;type:jsonb" json:"knockout"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// KnocksOut reports whether normalized answer values fail the question's
+// knockout rule. Unanswered questions never knock out.
+func (q *ScreeningQuestion) KnocksOut(values []string) bool {
+	rule := q.Knockout
+	if rule == nil || len(values) == 0 {
+		return false
+	}
+
+	switch q.Type {
+	case QuestionYesNo:
+		return rule.Expected != nil && (values[0] == "yes") != *rule.Expected
+	case QuestionNumber:
+		n, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			return false
+		}
+		return (rule.Min != nil && n < *rule.Min) || (rule.Max != nil && n > *rule.Max)
+	case QuestionSingleChoice, QuestionMultipleChoice:
+		for _, v := range values {
+			for _, a := range rule.Accepted {
+				if v == a {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// ScreeningAnswer keeps a copy of the question's prompt so answers stay
+// readable after the job's questions are edited.
+type ScreeningAnswer struct {
+	ID            uint           `gorm:"primaryKey" json:"id"`
+	ApplicationID uint           `gorm:"not null;index" json:"application_id"`
+	QuestionID    uint           `gorm:"not null" json:"question_id"`
+	Position      int            `gorm:"not null" json:"position"`
+	Prompt        string         `gorm:"not null" json:"prompt"`
+	Type          QuestionType   `gorm:"not null" json:"type"`
+	Values        []string       `gorm:"serializer:json;type:jsonb" json:"values"`
+	KnockedOut    bool           `gorm:"default:false" json:"knocked_out"`
+	Action        KnockoutAction `json:"action,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
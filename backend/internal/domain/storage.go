@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// FileStorage keeps uploaded files outside the database. Keys are
+// slash-separated paths chosen by the caller.
+type FileStorage interface {
+	Put(key string, data []byte, contentType string) error
+	Delete(key string) error
+	SignedURL(key, filename string, ttl time.Duration) (string, error)
+}
@@ -0,0 +1,75 @@
+package domain
+
+import "time"
+
+// Category is a managed job category. The defaults are seeded on startup and
+// admins can edit them afterwards.
+type Category struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Slug      string    `gorm:"uniqueIndex;not null" json:"slug"`
+	Name      string    `gorm:"not null" json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+var DefaultCategories = []Category{
+	{Slug: "tecnologia", Name: "Tecnologia"},
+	{Slug: "dados", Name: "Dados"},
+	{Slug: "design", Name: "Design"},
+	{Slug: "produto", Name: "Produto"},
+	{Slug: "marketing", Name: "Marketing"},
+	{Slug: "vendas", Name: "Vendas"},
+	{Slug: "financeiro", Name: "Financeiro"},
+	{Slug: "recursos-humanos", Name: "Recursos Humanos"},
+	{Slug: "operacoes", Name: "Operações"},
+	{Slug: "atendimento", Name: "Atendimento"},
+	{Slug: "juridico", Name: "Jurídico"},
+	{Slug: "saude", Name: "Saúde"},
+}
+
+type EmploymentType string
+
+const (
+	EmploymentCLT        EmploymentType = "CLT"
+	EmploymentPJ         EmploymentType = "PJ"
+	EmploymentContract   EmploymentType = "CONTRACT"
+	EmploymentInternship EmploymentType = "INTERNSHIP"
+)
+
+func (t EmploymentType) IsValid() bool {
+	switch t {
+	case EmploymentCLT, EmploymentPJ, EmploymentContract, EmploymentInternship:
+		return true
+	}
+	return false
+}
+
+type Seniority string
+
+const (
+	SeniorityIntern Seniority = "INTERN"
+	SeniorityJunior Seniority = "JUNIOR"
+	SeniorityMid    Seniority = "MID"
+	SenioritySenior Seniority = "SENIOR"
+	SeniorityLead   Seniority = "LEAD"
+)
+
+func (s Seniority) IsValid() bool {
+	switch s {
+	case SeniorityIntern, SeniorityJunior, SeniorityMid, SenioritySenior, SeniorityLead:
+		return true
+	}
+	return false
+}
+
+type WorkModel string
+
+const (
+	WorkRemote WorkModel = "REMOTE"
+	WorkHybrid WorkModel = "HYBRID"
+	WorkOnsite WorkModel = "ONSITE"
+)
+
+func (m WorkModel) IsValid() bool {
+	return m == WorkRemote || m == WorkHybrid || m == WorkOnsite
+}
@@ -11,15 +11,23 @@ type Role string
 const (
 	RoleCandidate Role = "CANDIDATE"
 	RoleRecruiter Role = "RECRUITER"
+	// RoleAdmin manages platform-wide data such as job categories. It cannot
+	// be chosen at registration.
+	RoleAdmin Role = "ADMIN"
 )
 
 type User struct {
-	ID        uint           `gorm:"primaryKey" json:"id"`
-	Name      string         `gorm:"not null" json:"name"`
-	Email     string         `gorm:"uniqueIndex;not null" json:"email"`
-	Password  string         `gorm:"not null" json:"-"`
-	Role      Role           `gorm:"default:'CANDIDATE'" json:"role"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID              uint           `gorm:"primaryKey" json:"id"`
+	Name            string         `gorm:"not null" json:"name"`
+	Email           string         `gorm:"uniqueIndex;not null" json:"email"`
+	Password        string         `gorm:"not null" json:"-"`
+	Role            Role           `gorm:"default:'CANDIDATE'" json:"role"`
+	EmailVerifiedAt *time.Time     `json:"email_verified_at"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
 }
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+)
+
+type TokenPurpose string
+
+const (
+	TokenPurposePasswordReset     TokenPurpose = "PASSWORD_RESET"
+	TokenPurposeEmailVerification TokenPurpose = "EMAIL_VERIFICATION"
+)
+
+type UserToken struct {
+	ID        uint         `gorm:"primaryKey" json:"id"`
+	UserID    uint         `gorm:"not null;index" json:"user_id"`
+	Purpose   TokenPurpose `gorm:"not null;index" json:"purpose"`
+	TokenHash string       `gorm:"uniqueIndex;not null" json:"-"`
+	ExpiresAt time.Time    `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time   `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+func (t *UserToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
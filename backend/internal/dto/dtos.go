@@ -1,6 +1,10 @@
 package dto
 
-import "github.com/helberthlucas14/internal/domain"
+import (
+	"time"
+
+	"github.com/helberthlucas14/internal/domain"
+)
 
 // Auth
 type RegisterInputDTO struct {
@@ -11,10 +15,11 @@ type RegisterInputDTO struct {
 }
 
 type RegisterOutputDTO struct {
-	ID    uint
-	Name  string
-	Email string
-	Role  domain.Role
+	ID            uint
+	Name          string
+	Email         string
+	Role          domain.Role
+	EmailVerified bool
 }
 
 type LoginInputDTO struct {
@@ -23,57 +28,164 @@ type LoginInputDTO struct {
 }
 
 type LoginOutputDTO struct {
-	Token string
+	Token        string
+	RefreshToken string
+	ExpiresIn    int64
+}
+
+type ResetPasswordInputDTO struct {
+	Token    string
+	Password string
 }
 
 // Job
 type CreateJobInputDTO struct {
-	Title        string `json:"title"`
-	Description  string `json:"description"`
-	Company      string `json:"company"`
-	Location     string `json:"location"`
-	Requirements string `json:"requirements"`
-	Salary       string `json:"salary"`
-	RecruiterID  uint   `json:"recruiter_id"`
-	Anonymous    bool   `json:"anonymous"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	Company        string     `json:"company"`
+	Location       string     `json:"location"`
+	Requirements   string     `json:"requirements"`
+	CategoryID     *uint      `json:"category_id"`
+	EmploymentType string     `json:"employment_type"`
+	Seniority      string     `json:"seniority"`
+	WorkModel      string     `json:"work_model"`
+	Salary         *SalaryDTO `json:"salary"`
+	OrganizationID uint       `json:"organization_id"`
+	Anonymous      bool       `json:"anonymous"`
+	PublishAt      *time.Time `json:"publish_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+	Draft          bool       `json:"draft"`
+	Openings       int        `json:"openings"`
+
+	Questions []ScreeningQuestionInputDTO `json:"questions"`
+	// Criteria names the scorecard criteria; empty uses the defaults.
+	Criteria []string `json:"criteria"`
 }
 
 type CreateJobOutputDTO struct {
-	ID             uint    `json:"id"`
-	Title          string  `json:"title"`
-	Description    string  `json:"description"`
-	Company        string  `json:"company"`
-	Location       string  `json:"location"`
-	Status         string  `json:"status"`
-	CreatedAt      string  `json:"created_at"`
-	RecruiterID    uint    `json:"recruiter_id"`
-	RecruiterEmail *string `json:"recruiter_email,omitempty"`
-	Anonymous      bool    `json:"anonymous"`
+	ID             uint               `json:"id"`
+	Title          string             `json:"title"`
+	Description    string             `json:"description"`
+	Company        string             `json:"company"`
+	Location       string             `json:"location"`
+	Latitude       *float64           `json:"latitude,omitempty"`
+	Longitude      *float64           `json:"longitude,omitempty"`
+	Category       *CategoryOutputDTO `json:"category,omitempty"`
+	EmploymentType string             `json:"employment_type,omitempty"`
+	Seniority      string             `json:"seniority,omitempty"`
+	WorkModel      string             `json:"work_model,omitempty"`
+	Salary         *SalaryDTO         `json:"salary,omitempty"`
+	Status         string             `json:"status"`
+	Openings       int                `json:"openings"`
+	PublishAt      *string            `json:"publish_at,omitempty"`
+	ExpiresAt      *string            `json:"expires_at,omitempty"`
+	CreatedAt      string             `json:"created_at"`
+	RecruiterID    uint               `json:"recruiter_id"`
+	OrganizationID uint               `json:"organization_id,omitempty"`
+	RecruiterEmail *string            `json:"recruiter_email,omitempty"`
+	Anonymous      bool               `json:"anonymous"`
+
+	Questions []ScreeningQuestionOutputDTO  `json:"questions,omitempty"`
+	Criteria  []ScorecardCriterionOutputDTO `json:"criteria,omitempty"`
 }
 
 type GetJobOutputDTO struct {
-	ID             uint    `json:"id"`
-	Title          string  `json:"title"`
-	Description    string  `json:"description"`
-	Company        string  `json:"company"`
-	Location       string  `json:"location"`
-	Requirements   string  `json:"requirements"`
-	Salary         string  `json:"salary"`
-	Status         string  `json:"status"`
-	CreatedAt      string  `json:"created_at"`
-	RecruiterID    uint    `json:"recruiter_id"`
-	RecruiterEmail *string `json:"recruiter_email,omitempty"`
-	Anonymous      bool    `json:"anonymous"`
+	ID             uint               `json:"id"`
+	Title          string             `json:"title"`
+	Description    string             `json:"description"`
+	Company        string             `json:"company"`
+	Location       string             `json:"location"`
+	Requirements   string             `json:"requirements"`
+	Latitude       *float64           `json:"latitude,omitempty"`
+	Longitude      *float64           `json:"longitude,omitempty"`
+	DistanceKm     *float64           `json:"distance_km,omitempty"`
+	Category       *CategoryOutputDTO `json:"category,omitempty"`
+	EmploymentType string             `json:"employment_type,omitempty"`
+	Seniority      string             `json:"seniority,omitempty"`
+	WorkModel      string             `json:"work_model,omitempty"`
+	Salary         *SalaryDTO         `json:"salary,omitempty"`
+	SalaryText     string             `json:"salary_text,omitempty"`
+	Status         string             `json:"status"`
+	Openings       int                `json:"openings"`
+	PublishAt      *string            `json:"publish_at,omitempty"`
+	ExpiresAt      *string            `json:"expires_at,omitempty"`
+	ArchivedAt     *string            `json:"archived_at,omitempty"`
+	DeletedAt      *string            `json:"deleted_at,omitempty"`
+	CreatedAt      string             `json:"created_at"`
+	RecruiterID    uint               `json:"recruiter_id"`
+	OrganizationID uint               `json:"organization_id,omitempty"`
+	RecruiterEmail *string            `json:"recruiter_email,omitempty"`
+	Anonymous      bool               `json:"anonymous"`
+
+	Highlights *JobHighlightsDTO             `json:"highlights,omitempty"`
+	Questions  []ScreeningQuestionOutputDTO  `json:"questions,omitempty"`
+	Criteria   []ScorecardCriterionOutputDTO `json:"criteria,omitempty"`
+}
+
+// JobHighlightsDTO holds snippets of a job matching the search query, with
+// matched words wrapped in <mark></mark>. The text is not HTML-escaped.
+type JobHighlightsDTO struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
 }
 
 type UpdateJobInputDTO struct {
-	Title        string `json:"title"`
-	Description  string `json:"description"`
-	Company      string `json:"company"`
-	Location     string `json:"location"`
-	Requirements string `json:"requirements"`
-	Salary       string `json:"salary"`
-	Status       string `json:"status"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	Company        string     `json:"company"`
+	Location       string     `json:"location"`
+	Requirements   string     `json:"requirements"`
+	EmploymentType string     `json:"employment_type"`
+	Seniority      string     `json:"seniority"`
+	WorkModel      string     `json:"work_model"`
+	Salary         *SalaryDTO `json:"salary"`
+	Status         string     `json:"status"`
+	PublishAt      *time.Time `json:"publish_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+	Openings       *int       `json:"openings"`
+
+	// CategoryID replaces the job's category when not nil; 0 clears it.
+	CategoryID *uint `json:"category_id"`
+	// Questions replaces the job's screening questions when not nil.
+	Questions *[]ScreeningQuestionInputDTO `json:"questions"`
+	// Criteria replaces the job's scorecard criteria when not nil. Criteria
+	// keeping their name keep their ratings.
+	Criteria *[]string `json:"criteria"`
+}
+
+// SalaryDTO is a pay range. A fixed amount has Min equal to Max; Visible
+// defaults to true and hides the salary from candidates when false.
+type SalaryDTO struct {
+	Min      *int   `json:"min" example:"5000"`
+	Max      *int   `json:"max" example:"8000"`
+	Currency string `json:"currency" example:"BRL"`
+	Period   string `json:"period" example:"MONTH"`
+	Visible  *bool  `json:"visible,omitempty"`
+}
+
+type KnockoutRuleDTO struct {
+	Action   string   `json:"action" example:"REJECT"`
+	Expected *bool    `json:"expected,omitempty"`
+	Min      *float64 `json:"min,omitempty"`
+	Max      *float64 `json:"max,omitempty"`
+	Accepted []string `json:"accepted,omitempty"`
+}
+
+type ScreeningQuestionInputDTO struct {
+	Prompt   string           `json:"prompt"`
+	Type     string           `json:"type" example:"YES_NO"`
+	Required bool             `json:"required"`
+	Options  []string         `json:"options"`
+	Knockout *KnockoutRuleDTO `json:"knockout"`
+}
+
+type ScreeningQuestionOutputDTO struct {
+	ID       uint             `json:"id"`
+	Prompt   string           `json:"prompt"`
+	Type     string           `json:"type"`
+	Required bool             `json:"required"`
+	Options  []string         `json:"options,omitempty"`
+	Knockout *KnockoutRuleDTO `json:"knockout,omitempty"`
 }
 
 type FinalizeJobInputDTO struct {
@@ -81,6 +193,103 @@ type FinalizeJobInputDTO struct {
 	CandidateID uint `json:"candidate_id"`
 }
 
+type HireCandidateInputDTO struct {
+	JobID       uint `json:"job_id"`
+	CandidateID uint `json:"candidate_id"`
+}
+
+// HireCandidateOutputDTO reports how the job's openings stand after a hire.
+// Closed is true when the hire filled the last opening.
+type HireCandidateOutputDTO struct {
+	JobID     uint   `json:"job_id"`
+	Status    string `json:"status"`
+	Openings  int    `json:"openings"`
+	Hired     int    `json:"hired"`
+	Remaining int    `json:"remaining"`
+	Closed    bool   `json:"closed"`
+}
+
+type CreateOfferInputDTO struct {
+	ApplicationID uint      `json:"application_id"`
+	Salary        int       `json:"salary"`
+	Currency      string    `json:"currency"`
+	Period        string    `json:"period"`
+	StartDate     time.Time `json:"start_date"`
+	Benefits      string    `json:"benefits"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// UpdateOfferInputDTO changes a draft offer. Nil fields are kept.
+type UpdateOfferInputDTO struct {
+	OfferID   uint       `json:"offer_id"`
+	Salary    *int       `json:"salary"`
+	Currency  *string    `json:"currency"`
+	Period    *string    `json:"period"`
+	StartDate *time.Time `json:"start_date"`
+	Benefits  *string    `json:"benefits"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+type OfferOutputDTO struct {
+	ID            uint    `json:"id"`
+	ApplicationID uint    `json:"application_id"`
+	JobID         uint    `json:"job_id"`
+	JobTitle      string  `json:"job_title"`
+	CandidateID   uint    `json:"candidate_id"`
+	CandidateName string  `json:"candidate_name"`
+	Salary        int     `json:"salary"`
+	Currency      string  `json:"currency"`
+	Period        string  `json:"period"`
+	StartDate     string  `json:"start_date"`
+	Benefits      string  `json:"benefits,omitempty"`
+	ExpiresAt     string  `json:"expires_at"`
+	Status        string  `json:"status"`
+	CreatedByID   uint    `json:"created_by_id"`
+	CreatedByName string  `json:"created_by_name"`
+	SentAt        *string `json:"sent_at,omitempty"`
+	RespondedAt   *string `json:"responded_at,omitempty"`
+	DeclineReason string  `json:"decline_reason,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+type AcceptOfferOutputDTO struct {
+	Offer  OfferOutputDTO         `json:"offer"`
+	Hiring HireCandidateOutputDTO `json:"hiring"`
+}
+
+type ReopenJobInputDTO struct {
+	JobID           uint       `json:"job_id"`
+	RestoreRejected bool       `json:"restore_rejected"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+}
+
+type ReopenJobOutputDTO struct {
+	GetJobOutputDTO
+	RestoredApplications int `json:"restored_applications"`
+}
+
+type SearchJobsInputDTO struct {
+	PaginationInputDTO
+	SalaryMin       *int
+	SalaryMax       *int
+	Currency        string
+	SalaryPeriod    string
+	SalaryBand      string
+	Categories      []string
+	Locations       []string
+	Companies       []string
+	EmploymentTypes []string
+	Seniorities     []string
+	WorkModels      []string
+	PostedWithin    string
+	Lat             *float64
+	Lng             *float64
+	RadiusKm        *float64
+	Near            string
+	Archived        bool
+	Deleted         bool
+}
+
 // Pagination
 type PaginationInputDTO struct {
 	Page   int    `form:"page" json:"page"`
@@ -97,14 +306,64 @@ type MetaDTO struct {
 }
 
 type PaginatedJobsOutputDTO struct {
-	Data []GetJobOutputDTO `json:"data"`
-	Meta MetaDTO           `json:"meta"`
+	Data   []GetJobOutputDTO `json:"data"`
+	Meta   MetaDTO           `json:"meta"`
+	Facets *JobFacetsDTO     `json:"facets,omitempty"`
+}
+
+type FacetCountDTO struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+type JobFacetsDTO struct {
+	Location       []FacetCountDTO `json:"location"`
+	Company        []FacetCountDTO `json:"company"`
+	EmploymentType []FacetCountDTO `json:"employment_type"`
+	Seniority      []FacetCountDTO `json:"seniority"`
+	WorkModel      []FacetCountDTO `json:"work_model"`
+	SalaryBand     []FacetCountDTO `json:"salary_band"`
+	PostedWithin   []FacetCountDTO `json:"posted_within"`
 }
 
 // Application
 type ApplyJobInputDTO struct {
-	JobID       uint `json:"job_id"`
-	CandidateID uint `json:"candidate_id"`
+	JobID       uint                      `json:"job_id"`
+	Resume      *FileInputDTO             `json:"-"`
+	CoverLetter *FileInputDTO             `json:"-"`
+	Answers     []ScreeningAnswerInputDTO `json:"answers"`
+}
+
+// ScreeningAnswerInputDTO answers one question: Value for single-valued
+// types, Values for MULTIPLE_CHOICE.
+type ScreeningAnswerInputDTO struct {
+	QuestionID uint     `json:"question_id"`
+	Value      string   `json:"value,omitempty"`
+	Values     []string `json:"values,omitempty"`
+}
+
+type ScreeningAnswerOutputDTO struct {
+	QuestionID uint     `json:"question_id"`
+	Prompt     string   `json:"prompt"`
+	Type       string   `json:"type"`
+	Values     []string `json:"values"`
+	KnockedOut bool     `json:"knocked_out"`
+	Action     string   `json:"action,omitempty"`
+}
+
+type FileInputDTO struct {
+	Filename string
+	Data     []byte
+}
+
+type AttachmentOutputDTO struct {
+	ID          uint   `json:"id"`
+	Kind        string `json:"kind"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	URL         string `json:"url,omitempty"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
 }
 
 type ApplyJobOutputDTO struct {
@@ -116,7 +375,233 @@ type ApplyJobOutputDTO struct {
 	CandidateID   uint   `json:"candidate_id"`
 	CandidateName string `json:"candidate_name,omitempty"`
 	Status        string `json:"status"`
+	StageID       uint   `json:"stage_id,omitempty"`
+	Stage         string `json:"stage,omitempty"`
 	AppliedAt     string `json:"applied_at"`
+
+	Flagged     bool                       `json:"flagged,omitempty"`
+	Answers     []ScreeningAnswerOutputDTO `json:"answers,omitempty"`
+	Attachments []AttachmentOutputDTO      `json:"attachments,omitempty"`
+	Profile     *ProfileSummaryDTO         `json:"profile,omitempty"`
+	Notes       []ApplicationNoteOutputDTO `json:"notes,omitempty"`
+
+	// AverageScore is only set once the caller has submitted their own
+	// scorecard for the application.
+	ScorecardCount int      `json:"scorecard_count,omitempty"`
+	AverageScore   *float64 `json:"average_score,omitempty"`
+}
+
+// JobApplicationsInputDTO filters the applications of a job. Score bounds
+// apply to the average of all scorecards of the applications the caller has
+// scored; Sort is score_desc, score_asc or empty for the newest first.
+type JobApplicationsInputDTO struct {
+	Page     int      `json:"page"`
+	Limit    int      `json:"limit"`
+	Status   string   `json:"status"`
+	MinScore *float64 `json:"min_score"`
+	MaxScore *float64 `json:"max_score"`
+	Sort     string   `json:"sort"`
+}
+
+type MoveApplicationInputDTO struct {
+	StageID uint   `json:"stage_id"`
+	Reason  string `json:"reason"`
+}
+
+type CancelApplicationInputDTO struct {
+	Reason string `json:"reason"`
+}
+
+type ApplicationEventOutputDTO struct {
+	ID         uint   `json:"id"`
+	Type       string `json:"type"`
+	FromStatus string `json:"from_status,omitempty"`
+	ToStatus   string `json:"to_status"`
+	FromStage  string `json:"from_stage,omitempty"`
+	ToStage    string `json:"to_stage,omitempty"`
+	ActorID    uint   `json:"actor_id,omitempty"`
+	ActorName  string `json:"actor_name,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type CreateNoteInputDTO struct {
+	ApplicationID uint   `json:"application_id"`
+	ParentID      *uint  `json:"parent_id"`
+	Body          string `json:"body"`
+	Private       bool   `json:"private"`
+	MentionIDs    []uint `json:"mention_ids"`
+}
+
+type UpdateNoteInputDTO struct {
+	ApplicationID uint   `json:"application_id"`
+	NoteID        uint   `json:"note_id"`
+	Body          string `json:"body"`
+	MentionIDs    []uint `json:"mention_ids"`
+}
+
+type NoteMentionOutputDTO struct {
+	UserID uint   `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+type NoteRevisionOutputDTO struct {
+	Body     string `json:"body"`
+	EditedAt string `json:"edited_at"`
+}
+
+// ApplicationNoteOutputDTO is one note of a thread. Top-level notes carry
+// their replies; Revisions lists earlier versions of the body, oldest first.
+type ApplicationNoteOutputDTO struct {
+	ID            uint                       `json:"id"`
+	ApplicationID uint                       `json:"application_id"`
+	ParentID      *uint                      `json:"parent_id,omitempty"`
+	AuthorID      uint                       `json:"author_id"`
+	AuthorName    string                     `json:"author_name"`
+	Body          string                     `json:"body"`
+	Private       bool                       `json:"private"`
+	Mentions      []NoteMentionOutputDTO     `json:"mentions"`
+	Revisions     []NoteRevisionOutputDTO    `json:"revisions,omitempty"`
+	Replies       []ApplicationNoteOutputDTO `json:"replies,omitempty"`
+	EditedAt      string                     `json:"edited_at,omitempty"`
+	CreatedAt     string                     `json:"created_at"`
+}
+
+type ScorecardCriterionOutputDTO struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type RatingInputDTO struct {
+	CriterionID uint `json:"criterion_id"`
+	Score       int  `json:"score"`
+}
+
+type SubmitScorecardInputDTO struct {
+	ApplicationID uint             `json:"application_id"`
+	Ratings       []RatingInputDTO `json:"ratings"`
+	Comment       string           `json:"comment"`
+}
+
+type RatingOutputDTO struct {
+	CriterionID uint   `json:"criterion_id"`
+	Criterion   string `json:"criterion"`
+	Score       int    `json:"score"`
+}
+
+type ScorecardOutputDTO struct {
+	ID              uint              `json:"id"`
+	ApplicationID   uint              `json:"application_id"`
+	InterviewerID   uint              `json:"interviewer_id"`
+	InterviewerName string            `json:"interviewer_name,omitempty"`
+	Ratings         []RatingOutputDTO `json:"ratings"`
+	Average         float64           `json:"average"`
+	Comment         string            `json:"comment,omitempty"`
+	SubmittedAt     string            `json:"submitted_at"`
+}
+
+// ApplicationScorecardsOutputDTO gathers the evaluations of an application.
+// Until the caller submits their own scorecard, Hidden is true and the other
+// interviewers' scorecards and the average are left out.
+type ApplicationScorecardsOutputDTO struct {
+	Criteria   []ScorecardCriterionOutputDTO `json:"criteria"`
+	Count      int                           `json:"count"`
+	Average    *float64                      `json:"average,omitempty"`
+	Hidden     bool                          `json:"hidden"`
+	Mine       *ScorecardOutputDTO           `json:"mine,omitempty"`
+	Scorecards []ScorecardOutputDTO          `json:"scorecards"`
+}
+
+type ScheduleInterviewInputDTO struct {
+	ApplicationID  uint      `json:"application_id"`
+	StartsAt       time.Time `json:"starts_at"`
+	EndsAt         time.Time `json:"ends_at"`
+	InterviewerIDs []uint    `json:"interviewer_ids"`
+	Location       string    `json:"location"`
+	VideoURL       string    `json:"video_url"`
+}
+
+// RescheduleInterviewInputDTO moves an interview. Interviewers, location and
+// video link are only replaced when not nil.
+type RescheduleInterviewInputDTO struct {
+	ApplicationID  uint      `json:"application_id"`
+	InterviewID    uint      `json:"interview_id"`
+	StartsAt       time.Time `json:"starts_at"`
+	EndsAt         time.Time `json:"ends_at"`
+	InterviewerIDs *[]uint   `json:"interviewer_ids"`
+	Location       *string   `json:"location"`
+	VideoURL       *string   `json:"video_url"`
+}
+
+type CancelInterviewInputDTO struct {
+	ApplicationID uint   `json:"application_id"`
+	InterviewID   uint   `json:"interview_id"`
+	Reason        string `json:"reason"`
+}
+
+type InterviewOutcomeInputDTO struct {
+	ApplicationID uint   `json:"application_id"`
+	InterviewID   uint   `json:"interview_id"`
+	Status        string `json:"status"`
+}
+
+type InterviewerOutputDTO struct {
+	UserID uint   `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+type InterviewOutputDTO struct {
+	ID              uint                   `json:"id"`
+	ApplicationID   uint                   `json:"application_id"`
+	JobID           uint                   `json:"job_id"`
+	JobTitle        string                 `json:"job_title"`
+	CandidateID     uint                   `json:"candidate_id"`
+	CandidateName   string                 `json:"candidate_name"`
+	UID             string                 `json:"uid"`
+	Sequence        int                    `json:"sequence"`
+	StartsAt        string                 `json:"starts_at"`
+	EndsAt          string                 `json:"ends_at"`
+	Location        string                 `json:"location,omitempty"`
+	VideoURL        string                 `json:"video_url,omitempty"`
+	Status          string                 `json:"status"`
+	Interviewers    []InterviewerOutputDTO `json:"interviewers"`
+	ScheduledByID   uint                   `json:"scheduled_by_id"`
+	ScheduledByName string                 `json:"scheduled_by_name"`
+	CancelReason    string                 `json:"cancel_reason,omitempty"`
+	CreatedAt       string                 `json:"created_at"`
+}
+
+// PublishInterviewSlotsInputDTO splits an availability window into slots of
+// DurationMinutes each.
+type PublishInterviewSlotsInputDTO struct {
+	JobID           uint      `json:"job_id"`
+	StartsAt        time.Time `json:"starts_at"`
+	EndsAt          time.Time `json:"ends_at"`
+	DurationMinutes int       `json:"duration_minutes"`
+	InterviewerID   uint      `json:"interviewer_id"`
+	StageID         *uint     `json:"stage_id"`
+	Location        string    `json:"location"`
+	VideoURL        string    `json:"video_url"`
+}
+
+type BookInterviewSlotInputDTO struct {
+	ApplicationID uint `json:"application_id"`
+	SlotID        uint `json:"slot_id"`
+}
+
+type InterviewSlotOutputDTO struct {
+	ID              uint   `json:"id"`
+	JobID           uint   `json:"job_id"`
+	StageID         *uint  `json:"stage_id,omitempty"`
+	InterviewerID   uint   `json:"interviewer_id"`
+	InterviewerName string `json:"interviewer_name"`
+	StartsAt        string `json:"starts_at"`
+	EndsAt          string `json:"ends_at"`
+	Location        string `json:"location,omitempty"`
+	VideoURL        string `json:"video_url,omitempty"`
+	Booked          bool   `json:"booked"`
+	InterviewID     *uint  `json:"interview_id,omitempty"`
+	CandidateName   string `json:"candidate_name,omitempty"`
 }
 
 type PaginatedApplicationsOutputDTO struct {
@@ -128,3 +613,161 @@ type DashboardStatsDTO struct {
 	Applied int64 `json:"applied"`
 	Pending int64 `json:"pending"`
 }
+
+// Organization
+type CreateOrganizationInputDTO struct {
+	Name string `json:"name"`
+}
+
+type UpdateOrganizationInputDTO struct {
+	Name                string  `json:"name"`
+	RequireJobApproval  *bool   `json:"require_job_approval"`
+	OfferLetterTemplate *string `json:"offer_letter_template"`
+}
+
+type OrganizationOutputDTO struct {
+	ID                  uint   `json:"id"`
+	Name                string `json:"name"`
+	Role                string `json:"role"`
+	RequireJobApproval  bool   `json:"require_job_approval"`
+	OfferLetterTemplate string `json:"offer_letter_template,omitempty"`
+	CreatedAt           string `json:"created_at"`
+}
+
+type UpdateMemberInputDTO struct {
+	OrganizationID uint   `json:"organization_id"`
+	UserID         uint   `json:"user_id"`
+	Role           string `json:"role"`
+}
+
+type OrganizationMemberOutputDTO struct {
+	UserID   uint   `json:"user_id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Role     string `json:"role"`
+	JoinedAt string `json:"joined_at"`
+}
+
+type InviteMemberInputDTO struct {
+	OrganizationID uint   `json:"organization_id"`
+	Email          string `json:"email"`
+}
+
+type InvitationOutputDTO struct {
+	ID             uint   `json:"id"`
+	OrganizationID uint   `json:"organization_id"`
+	Email          string `json:"email"`
+	Status         string `json:"status"`
+	ExpiresAt      string `json:"expires_at"`
+	CreatedAt      string `json:"created_at"`
+}
+
+// Job approval
+type DecideJobInputDTO struct {
+	JobID   uint   `json:"job_id"`
+	Approve bool   `json:"approve"`
+	Comment string `json:"comment"`
+}
+
+type JobApprovalOutputDTO struct {
+	ID              uint    `json:"id"`
+	JobID           uint    `json:"job_id"`
+	JobTitle        string  `json:"job_title"`
+	OrganizationID  uint    `json:"organization_id"`
+	Status          string  `json:"status"`
+	RequestedByID   uint    `json:"requested_by_id"`
+	RequestedByName string  `json:"requested_by_name,omitempty"`
+	Comment         string  `json:"comment"`
+	DecidedByID     *uint   `json:"decided_by_id,omitempty"`
+	DecidedByName   string  `json:"decided_by_name,omitempty"`
+	DecisionComment string  `json:"decision_comment,omitempty"`
+	DecidedAt       *string `json:"decided_at,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+type PaginatedJobApprovalsOutputDTO struct {
+	Data []JobApprovalOutputDTO `json:"data"`
+	Meta MetaDTO                `json:"meta"`
+}
+
+// Category
+type CategoryInputDTO struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type CategoryOutputDTO struct {
+	ID   uint   `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// Pipeline
+type PipelineStageOutputDTO struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Position int    `json:"position"`
+	Kind     string `json:"kind"`
+}
+
+type UpdatePipelineInputDTO struct {
+	Stages []string `json:"stages"`
+}
+
+// Candidate profile
+type ProfileLinkDTO struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+type WorkExperienceDTO struct {
+	Title       string `json:"title"`
+	Company     string `json:"company"`
+	Location    string `json:"location"`
+	StartDate   string `json:"start_date" example:"2021-03"`
+	EndDate     string `json:"end_date" example:"2023-08"`
+	Description string `json:"description"`
+}
+
+type EducationDTO struct {
+	Institution  string `json:"institution"`
+	Degree       string `json:"degree"`
+	FieldOfStudy string `json:"field_of_study"`
+	StartDate    string `json:"start_date" example:"2016-02"`
+	EndDate      string `json:"end_date" example:"2020-12"`
+}
+
+type CandidateProfileInputDTO struct {
+	Headline        string              `json:"headline"`
+	Location        string              `json:"location"`
+	DesiredSalary   *int                `json:"desired_salary"`
+	DesiredCurrency string              `json:"desired_currency"`
+	Skills          []string            `json:"skills"`
+	Links           []ProfileLinkDTO    `json:"links"`
+	Experiences     []WorkExperienceDTO `json:"experiences"`
+	Education       []EducationDTO      `json:"education"`
+}
+
+type CandidateProfileOutputDTO struct {
+	UserID          uint                `json:"user_id"`
+	Headline        string              `json:"headline"`
+	Location        string              `json:"location"`
+	DesiredSalary   *int                `json:"desired_salary"`
+	DesiredCurrency string              `json:"desired_currency,omitempty"`
+	Skills          []string            `json:"skills"`
+	Links           []ProfileLinkDTO    `json:"links"`
+	Experiences     []WorkExperienceDTO `json:"experiences"`
+	Education       []EducationDTO      `json:"education"`
+	UpdatedAt       string              `json:"updated_at"`
+}
+
+type ProfileSummaryDTO struct {
+	Headline          string   `json:"headline"`
+	Location          string   `json:"location"`
+	CurrentTitle      string   `json:"current_title,omitempty"`
+	CurrentCompany    string   `json:"current_company,omitempty"`
+	YearsOfExperience int      `json:"years_of_experience"`
+	Skills            []string `json:"skills"`
+	DesiredSalary     *int     `json:"desired_salary,omitempty"`
+	DesiredCurrency   string   `json:"desired_currency,omitempty"`
+}
@@ -0,0 +1,291 @@
+package database
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/helberthlucas14/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// dataMigration records a one-time step of MigrateData that already ran.
+type dataMigration struct {
+	Name      string `gorm:"primaryKey"`
+	AppliedAt time.Time
+}
+
+// MigrateData runs idempotent backfills that schema auto-migration cannot
+// express. Each step only touches rows still in their legacy shape, so it is
+// safe to call on every startup; steps marked once cannot tell legacy rows
+// apart and are recorded so they only ever run a single time.
+func MigrateData(geocoder domain.Geocoder) {
+	if err := DB.AutoMigrate(&dataMigration{}); err != nil {
+		log.Fatal("Failed to migrate database:", err)
+	}
+
+	steps := []struct {
+		name string
+		run  func(tx *gorm.DB) error
+		once bool
+	}{
+		{"mark existing accounts as verified", backfillEmailVerification, true},
+		{"assign legacy jobs to organizations", backfillJobOrganizations, false},
+		{"seed timelines of legacy applications", backfillApplicationEvents, false},
+		{"structure legacy salaries", backfillSalaries, false},
+		{"index jobs for full-text search", indexJobSearch, false},
+		{"seed job categories", seedCategories, false},
+		{"geocode job locations", geocodeJobs(geocoder), false},
+		{"keep one default pipeline per organization", uniqueDefaultPipelines, false},
+	}
+
+	for _, step := range steps {
+		err := DB.Transaction(func(tx *gorm.DB) error {
+			if step.once {
+				result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dataMigration{Name: step.name, AppliedAt: time.Now()})
+				if result.Error != nil || result.RowsAffected == 0 {
+					return result.Error
+				}
+			}
+			return step.run(tx)
+		})
+		if err != nil {
+			log.Fatalf("Failed data migration %q: %v", step.name, err)
+		}
+	}
+	log.Println("Data migration completed")
+}
+
+// backfillEmailVerification treats the accounts created before email
+// verification existed as verified, from their creation, so they can keep
+// posting jobs and applying. Accounts that were sent a verification email
+// signed up afterwards and must still verify.
+func backfillEmailVerification(tx *gorm.DB) error {
+	return tx.Unscoped().Model(&domain.User{}).
+		Where("email_verified_at IS NULL AND NOT EXISTS (SELECT 1 FROM user_tokens t WHERE t.user_id = users.id AND t.purpose = ?)", domain.TokenPurposeEmailVerification).
+		UpdateColumn("email_verified_at", gorm.Expr("created_at")).Error
+}
+
+// backfillJobOrganizations gives every recruiter that still owns jobs without
+// an organization a personal organization (named after their latest posting's
+// company) and moves those jobs into it.
+func backfillJobOrganizations(tx *gorm.DB) error {
+	var recruiterIDs []uint
+	if err := tx.Unscoped().Model(&domain.Job{}).
+		Where("organization_id IS NULL AND recruiter_id <> 0").
+		Distinct().Pluck("recruiter_id", &recruiterIDs).Error; err != nil {
+		return err
+	}
+
+	for _, recruiterID := range recruiterIDs {
+		var member domain.OrganizationMember
+		err := tx.Where("user_id = ?", recruiterID).Order("created_at asc").First(&member).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			var latest domain.Job
+			if err := tx.Unscoped().Where("recruiter_id = ?", recruiterID).Order("created_at desc").First(&latest).Error; err != nil {
+				return err
+			}
+
+			org := domain.Organization{Name: latest.Company}
+			if err := tx.Create(&org).Error; err != nil {
+				return err
+			}
+			member = domain.OrganizationMember{OrganizationID: org.ID, UserID: recruiterID, Role: domain.OrgRoleOwner}
+			if err := tx.Create(&member).Error; err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&domain.Job{}).
+			Where("recruiter_id = ? AND organization_id IS NULL", recruiterID).
+			Update("organization_id", member.OrganizationID).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillApplicationEvents starts the timeline of applications created before
+// events were recorded: an APPLIED event at creation time and, for decided
+// applications, one more event at their last update with no known actor.
+func backfillApplicationEvents(tx *gorm.DB) error {
+	var apps []domain.Application
+	if err := tx.Unscoped().Preload("Stage").
+		Where("NOT EXISTS (SELECT 1 FROM application_events e WHERE e.application_id = applications.id)").
+		Find(&apps).Error; err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		candidateID := app.CandidateID
+		events := []domain.ApplicationEvent{{
+			ApplicationID: app.ID,
+			Type:          domain.EventApplied,
+			ActorID:       &candidateID,
+			ToStatus:      domain.StatusPending,
+			CreatedAt:     app.CreatedAt,
+		}}
+
+		eventType := map[domain.ApplicationStatus]domain.ApplicationEventType{
+			domain.StatusHired:    domain.EventHired,
+			domain.StatusRejected: domain.EventRejected,
+			domain.StatusCanceled: domain.EventCanceled,
+		}[app.Status]
+		if eventType == "" && app.Stage != nil {
+			if app.Stage.Position == 1 {
+				events[0].ToStage = app.Stage.Name
+			} else {
+				eventType = domain.EventStageChanged
+			}
+		}
+		if eventType != "" {
+			last := domain.ApplicationEvent{
+				ApplicationID: app.ID,
+				Type:          eventType,
+				ActorID:       app.StageChangedByID,
+				FromStatus:    domain.StatusPending,
+				ToStatus:      app.Status,
+				CreatedAt:     app.UpdatedAt,
+			}
+			if eventType == domain.EventCanceled {
+				last.ActorID = &candidateID
+			}
+			if app.Stage != nil {
+				last.ToStage = app.Stage.Name
+			}
+			events = append(events, last)
+		}
+
+		if err := tx.Create(&events).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillSalaries parses the free-text salary of jobs without a structured
+// range. Parsed text is cleared; text that does not parse is kept as is and
+// still shown to candidates verbatim.
+func backfillSalaries(tx *gorm.DB) error {
+	var jobs []domain.Job
+	if err := tx.Unscoped().
+		Where("salary_min IS NULL AND salary_max IS NULL AND salary <> ''").
+		Find(&jobs).Error; err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		r, ok := domain.ParseSalary(job.Salary)
+		if !ok {
+			log.Printf("Data migration: kept unparsed salary %q of job %d", job.Salary, job.ID)
+			continue
+		}
+		if err := tx.Unscoped().Model(&domain.Job{}).Where("id = ?", job.ID).Updates(map[string]any{
+			"salary_min":      r.Min,
+			"salary_max":      r.Max,
+			"salary_currency": r.Currency,
+			"salary_period":   r.Period,
+			"salary":          "",
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexJobSearch adds the generated search_vector column behind job search and
+// its GIN index. Every text field is indexed with both the Portuguese and the
+// English configuration, weighted title > company/location > requirements >
+// description.
+func indexJobSearch(tx *gorm.DB) error {
+	if err := tx.Exec(`ALTER TABLE jobs ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+		setweight(to_tsvector('portuguese', coalesce(title, '')), 'A') ||
+		setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+		setweight(to_tsvector('portuguese', coalesce(company, '') || ' ' || coalesce(location, '')), 'B') ||
+		setweight(to_tsvector('english', coalesce(company, '') || ' ' || coalesce(location, '')), 'B') ||
+		setweight(to_tsvector('portuguese', coalesce(requirements, '')), 'C') ||
+		setweight(to_tsvector('english', coalesce(requirements, '')), 'C') ||
+		setweight(to_tsvector('portuguese', coalesce(description, '')), 'D') ||
+		setweight(to_tsvector('english', coalesce(description, '')), 'D')
+	) STORED`).Error; err != nil {
+		return err
+	}
+	return tx.Exec("CREATE INDEX IF NOT EXISTS idx_jobs_search_vector ON jobs USING GIN (search_vector)").Error
+}
+
+// seedCategories installs the default job categories on a fresh database.
+// Once any category exists they are left to the admins.
+func seedCategories(tx *gorm.DB) error {
+	var count int64
+	if err := tx.Model(&domain.Category{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	categories := make([]domain.Category, len(domain.DefaultCategories))
+	copy(categories, domain.DefaultCategories)
+	return tx.Create(&categories).Error
+}
+
+// uniqueDefaultPipelines merges the default pipelines created twice by
+// concurrent first requests into the oldest one, matching stages by kind and
+// name, then indexes the pipelines so an organization can only have one.
+func uniqueDefaultPipelines(tx *gorm.DB) error {
+	duplicates := `SELECT id, MIN(id) OVER (PARTITION BY organization_id, kind, lower(name)) AS keep_id
+		FROM pipeline_stages WHERE job_id IS NULL`
+	for _, table := range []string{"applications", "interview_slots"} {
+		if err := tx.Exec(`UPDATE ` + table + ` t SET stage_id = d.keep_id FROM (` + duplicates + `) d
+			WHERE t.stage_id = d.id AND d.id <> d.keep_id`).Error; err != nil {
+			return err
+		}
+	}
+	if err := tx.Exec(`DELETE FROM pipeline_stages p USING (` + duplicates + `) d
+		WHERE p.id = d.id AND d.id <> d.keep_id`).Error; err != nil {
+		return err
+	}
+	return tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_pipeline_stages_default_hired
+		ON pipeline_stages (organization_id) WHERE job_id IS NULL AND kind = 'HIRED'`).Error
+}
+
+// geocodeJobs places jobs that have never been geocoded. Jobs whose location
+// is unknown to the geocoder are retried on the next startup, so a richer
+// geocoder picks them up later.
+func geocodeJobs(geocoder domain.Geocoder) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		var jobs []domain.Job
+		if err := tx.Unscoped().Select("id", "location").
+			Where("latitude IS NULL AND location <> ''").
+			Find(&jobs).Error; err != nil {
+			return err
+		}
+
+		located := 0
+		for _, job := range jobs {
+			point, ok, err := geocoder.Geocode(job.Location)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if err := tx.Unscoped().Model(&domain.Job{}).Where("id = ?", job.ID).Updates(map[string]any{
+				"latitude":  point.Lat,
+				"longitude": point.Lng,
+			}).Error; err != nil {
+				return err
+			}
+			located++
+		}
+		if located > 0 {
+			log.Printf("Data migration: geocoded %d jobs", located)
+		}
+		return nil
+	}
+}
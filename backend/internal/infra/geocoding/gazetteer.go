@@ -0,0 +1,127 @@
+package geocoding
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/helberthlucas14/internal/domain"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// brCities lists Brazilian cities as "name;UF;latitude;longitude", largest
+// first: state capitals and the most populous municipalities.
+//
+//go:embed br_cities.csv
+var brCities []byte
+
+var states = map[string]bool{
+	"ac": true, "al": true, "ap": true, "am": true, "ba": true, "ce": true, "df": true,
+	"es": true, "go": true, "ma": true, "mt": true, "ms": true, "mg": true, "pa": true,
+	"pb": true, "pr": true, "pe": true, "pi": true, "rj": true, "rn": true, "rs": true,
+	"ro": true, "rr": true, "sc": true, "sp": true, "se": true, "to": true,
+}
+
+// aliases maps common nicknames to the city name they stand for.
+var aliases = map[string]string{
+	"bh":      "belo horizonte",
+	"poa":     "porto alegre",
+	"rio":     "rio de janeiro",
+	"sampa":   "sao paulo",
+	"floripa": "florianopolis",
+	"jampa":   "joao pessoa",
+}
+
+type city struct {
+	state string
+	point domain.GeoPoint
+}
+
+// Gazetteer geocodes locations written the way job postings usually are —
+// "Belo Horizonte - MG", "Curitiba/PR", "Remoto (São Paulo)" — by looking
+// their city up in an embedded list, without any network access. Matching
+// ignores case and accents; a state abbreviation picks between homonymous
+// cities, otherwise the largest one wins.
+type Gazetteer struct {
+	cities map[string][]city
+}
+
+func NewGazetteer() *Gazetteer {
+	g := &Gazetteer{cities: map[string][]city{}}
+	scanner := bufio.NewScanner(bytes.NewReader(brCities))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ";")
+		if len(fields) != 4 {
+			continue
+		}
+		lat, errLat := strconv.ParseFloat(fields[2], 64)
+		lng, errLng := strconv.ParseFloat(fields[3], 64)
+		if errLat != nil || errLng != nil {
+			continue
+		}
+		name := normalize(fields[0])
+		g.cities[name] = append(g.cities[name], city{
+			state: normalize(fields[1]),
+			point: domain.GeoPoint{Lat: lat, Lng: lng},
+		})
+	}
+	return g
+}
+
+// Len is the number of cities the gazetteer knows.
+func (g *Gazetteer) Len() int {
+	n := 0
+	for _, c := range g.cities {
+		n += len(c)
+	}
+	return n
+}
+
+func (g *Gazetteer) Geocode(location string) (domain.GeoPoint, bool, error) {
+	parts := strings.FieldsFunc(normalize(location), func(r rune) bool {
+		return strings.ContainsRune(",/|()–;", r)
+	})
+
+	var names []string
+	state := ""
+	for _, part := range parts {
+		for _, p := range strings.Split(part, " - ") {
+			p = strings.TrimSpace(p)
+			switch {
+			case p == "":
+			case states[p]:
+				if state == "" {
+					state = p
+				}
+			default:
+				names = append(names, p)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if alias, ok := aliases[name]; ok {
+			name = alias
+		}
+		for _, c := range g.cities[name] {
+			if state == "" || c.state == state {
+				return c.point, true, nil
+			}
+		}
+	}
+	return domain.GeoPoint{}, false, nil
+}
+
+// normalize lowercases s, strips accents and collapses whitespace.
+func normalize(s string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), s)
+	if err != nil {
+		folded = s
+	}
+	return strings.Join(strings.Fields(strings.ToLower(folded)), " ")
+}
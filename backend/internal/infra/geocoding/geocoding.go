@@ -0,0 +1,30 @@
+package geocoding
+
+import (
+	"log"
+
+	"github.com/helberthlucas14/internal/config"
+	"github.com/helberthlucas14/internal/domain"
+)
+
+// NewGeocoder picks the geocoder configured by GEOCODER_DRIVER. "none" leaves
+// every job without coordinates; anything else resolves locations offline
+// with the built-in gazetteer of Brazilian cities.
+func NewGeocoder(cfg *config.Config) domain.Geocoder {
+	switch cfg.GeocoderDriver {
+	case "none":
+		log.Println("Geocoder: disabled, jobs will not be placed on the map")
+		return NoopGeocoder{}
+	default:
+		g := NewGazetteer()
+		log.Println("Geocoder: using the built-in gazetteer of", g.Len(), "Brazilian cities")
+		return g
+	}
+}
+
+// NoopGeocoder knows no location.
+type NoopGeocoder struct{}
+
+func (NoopGeocoder) Geocode(string) (domain.GeoPoint, bool, error) {
+	return domain.GeoPoint{}, false, nil
+}
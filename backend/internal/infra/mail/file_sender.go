@@ -0,0 +1,35 @@
+package mail
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+// FileSender is a development sink that stores every message as an .eml file
+// instead of delivering it.
+type FileSender struct {
+	dir  string
+	from string
+}
+
+func NewFileSender(dir, from string) *FileSender {
+	return &FileSender{dir: dir, from: from}
+}
+
+func (s *FileSender) Send(msg domain.MailMessage) error {
+	body, err := buildMessage(s.from, msg)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s.eml", time.Now().Format("20060102T150405.000000000"))
+	return os.WriteFile(filepath.Join(s.dir, name), body, 0o644)
+}
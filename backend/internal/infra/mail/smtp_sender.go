@@ -0,0 +1,33 @@
+package mail
+
+import (
+	"net/smtp"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type SMTPSender struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPSender(host, port, username, password, from string) *SMTPSender {
+	return &SMTPSender{host: host, port: port, username: username, password: password, from: from}
+}
+
+func (s *SMTPSender) Send(msg domain.MailMessage) error {
+	body, err := buildMessage(s.from, msg)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.username != "" {
+		auth = smtp.PlainAuth("", s.username, s.password, s.host)
+	}
+
+	return smtp.SendMail(s.host+":"+s.port, auth, s.from, msg.To, body)
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ApplicationNoteRepository struct{}
+
+func NewApplicationNoteRepository() *ApplicationNoteRepository {
+	return &ApplicationNoteRepository{}
+}
+
+// Create stores a note together with its mentions.
+func (r *ApplicationNoteRepository) Create(note *domain.ApplicationNote) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(note).Error; err != nil {
+			return err
+		}
+		return createMentions(tx, note)
+	})
+}
+
+// UpdateWithRevision saves an edited note, replaces its mentions and records
+// the text it had before in one transaction.
+func (r *ApplicationNoteRepository) UpdateWithRevision(note *domain.ApplicationNote, revision *domain.NoteRevision) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.ApplicationNote{}).Where("id = ?", note.ID).Updates(map[string]any{
+			"body":      note.Body,
+			"edited_at": note.EditedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		revision.NoteID = note.ID
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("note_id = ?", note.ID).Delete(&domain.NoteMention{}).Error; err != nil {
+			return err
+		}
+		return createMentions(tx, note)
+	})
+}
+
+func (r *ApplicationNoteRepository) FindByID(id uint) (*domain.ApplicationNote, error) {
+	var note domain.ApplicationNote
+	err := database.DB.
+		Preload("Application").
+		Preload("Application.Job", withDeleted).
+		Preload("Author").
+		Preload("Mentions.User").
+		Preload("Revisions", orderByCreation).
+		First(&note, id).Error
+	return &note, err
+}
+
+// FindByApplicationIDs returns every note of the given applications, oldest
+// first, with their authors, mentions and edit history.
+func (r *ApplicationNoteRepository) FindByApplicationIDs(appIDs []uint) ([]domain.ApplicationNote, error) {
+	var notes []domain.ApplicationNote
+	if len(appIDs) == 0 {
+		return notes, nil
+	}
+	err := database.DB.
+		Preload("Author").
+		Preload("Mentions.User").
+		Preload("Revisions", orderByCreation).
+		Where("application_id IN ?", appIDs).
+		Order("created_at asc, id asc").
+		Find(&notes).Error
+	return notes, err
+}
+
+func createMentions(tx *gorm.DB, note *domain.ApplicationNote) error {
+	if len(note.Mentions) == 0 {
+		return nil
+	}
+	for i := range note.Mentions {
+		note.Mentions[i].ID = 0
+		note.Mentions[i].NoteID = note.ID
+	}
+	return tx.Omit("User").Create(&note.Mentions).Error
+}
+
+func orderByCreation(db *gorm.DB) *gorm.DB {
+	return db.Order("created_at asc, id asc")
+}
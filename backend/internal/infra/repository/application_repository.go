@@ -1,8 +1,12 @@
 package repository
 
 import (
+	"errors"
+
 	"github.com/helberthlucas14/internal/domain"
 	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ApplicationRepository struct{}
@@ -19,6 +23,66 @@ func (r *ApplicationRepository) Update(app *domain.Application) error {
 	return database.DB.Save(app).Error
 }
 
+// CreateWithEvents stores a new application and its first timeline events in
+// one transaction.
+func (r *ApplicationRepository) CreateWithEvents(app *domain.Application, events ...*domain.ApplicationEvent) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := lockStage(tx, app.StageID); err != nil {
+			return err
+		}
+		if err := tx.Create(app).Error; err != nil {
+			return err
+		}
+		for _, event := range events {
+			event.ApplicationID = app.ID
+			if err := tx.Create(event).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// UpdateWithEvent saves an application transition together with the event
+// describing it, so the timeline never misses a change.
+func (r *ApplicationRepository) UpdateWithEvent(app *domain.Application, event *domain.ApplicationEvent) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		return transitionApplication(tx, app, event)
+	})
+}
+
+// transitionApplication writes the application's new status and stage and
+// records event. The transition only applies while the application is still
+// in the event's FromStatus, so a concurrent change, e.g. a hire, is never
+// overwritten.
+func transitionApplication(tx *gorm.DB, app *domain.Application, event *domain.ApplicationEvent) error {
+	if err := lockStage(tx, app.StageID); err != nil {
+		return err
+	}
+	result := tx.Model(&domain.Application{}).
+		Where("id = ? AND status = ?", app.ID, event.FromStatus).
+		Updates(map[string]interface{}{
+			"status":              app.Status,
+			"stage_id":            app.StageID,
+			"stage_changed_at":    app.StageChangedAt,
+			"stage_changed_by_id": app.StageChangedByID,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("application status changed, reload it and try again")
+	}
+	event.ApplicationID = app.ID
+	return tx.Create(event).Error
+}
+
+func (r *ApplicationRepository) FindEvents(appID uint) ([]domain.ApplicationEvent, error) {
+	var events []domain.ApplicationEvent
+	err := database.DB.Preload("Actor").Where("application_id = ?", appID).Order("created_at asc, id asc").Find(&events).Error
+	return events, err
+}
+
 func (r *ApplicationRepository) FindByCandidateID(candidateID uint, page, limit int) ([]domain.Application, int64, error) {
 	var apps []domain.Application
 	var total int64
@@ -30,31 +94,50 @@ func (r *ApplicationRepository) FindByCandidateID(candidateID uint, page, limit
 	}
 
 	offset := (page - 1) * limit
-	err := db.Preload("Job").Limit(limit).Offset(offset).Order("created_at desc").Find(&apps).Error
+	err := db.Preload("Job", withDeleted).Preload("Stage").Limit(limit).Offset(offset).Order("created_at desc").Find(&apps).Error
 	return apps, total, err
 }
 
 func (r *ApplicationRepository) FindByJobID(jobID uint) ([]domain.Application, error) {
 	var apps []domain.Application
-	err := database.DB.Preload("Candidate").Where("job_id = ?", jobID).Find(&apps).Error
+	err := database.DB.Preload("Candidate").Preload("Stage").Where("job_id = ?", jobID).Find(&apps).Error
 	return apps, err
 }
 
-func (r *ApplicationRepository) FindPaginatedByJobID(jobID uint, page, limit int, status string) ([]domain.Application, int64, error) {
+// applicationScore is the average of all scorecards of an application. It is
+// NULL unless the user bound to ? has submitted a scorecard for it.
+const applicationScore = "(SELECT AVG(scorecards.average) FROM scorecards WHERE scorecards.application_id = applications.id" +
+	" AND EXISTS (SELECT 1 FROM scorecards mine WHERE mine.application_id = applications.id AND mine.interviewer_id = ?))"
+
+func (r *ApplicationRepository) FindPaginatedByJobID(jobID uint, page, limit int, filter domain.ApplicationFilter) ([]domain.Application, int64, error) {
 	var apps []domain.Application
 	var total int64
 
 	db := database.DB.Model(&domain.Application{}).Where("job_id = ?", jobID)
-	if status != "" {
-		db = db.Where("status = ?", status)
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.MinScore != nil {
+		db = db.Where(applicationScore+" >= ?", filter.ScoredBy, *filter.MinScore)
+	}
+	if filter.MaxScore != nil {
+		db = db.Where(applicationScore+" <= ?", filter.ScoredBy, *filter.MaxScore)
 	}
 
 	if err := db.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
+	order := clause.Expr{SQL: "created_at desc", WithoutParentheses: true}
+	switch filter.Sort {
+	case domain.ApplicationSortScoreDesc:
+		order = clause.Expr{SQL: applicationScore + " desc NULLS LAST, created_at desc", Vars: []interface{}{filter.ScoredBy}, WithoutParentheses: true}
+	case domain.ApplicationSortScoreAsc:
+		order = clause.Expr{SQL: applicationScore + " asc NULLS LAST, created_at desc", Vars: []interface{}{filter.ScoredBy}, WithoutParentheses: true}
+	}
+
 	offset := (page - 1) * limit
-	err := db.Preload("Candidate").Limit(limit).Offset(offset).Order("created_at desc").Find(&apps).Error
+	err := db.Preload("Candidate").Preload("Stage").Preload("Attachments").Preload("Answers", orderByPosition).Limit(limit).Offset(offset).Order(clause.OrderBy{Expression: order}).Find(&apps).Error
 	return apps, total, err
 }
 
@@ -68,7 +151,7 @@ func (r *ApplicationRepository) Exists(jobID, candidateID uint) (bool, error) {
 
 func (r *ApplicationRepository) FindByID(id uint) (*domain.Application, error) {
 	var app domain.Application
-	err := database.DB.Preload("Job").First(&app, id).Error
+	err := database.DB.Preload("Job", withDeleted).Preload("Job.Criteria", orderByPosition).Preload("Job.Recruiter").Preload("Candidate").Preload("Stage").Preload("Attachments").First(&app, id).Error
 	return &app, err
 }
 
@@ -92,3 +175,9 @@ func (r *ApplicationRepository) GetPendingCount(candidateID uint) (int64, error)
 	}
 	return pending, nil
 }
+
+// withDeleted loads soft-deleted rows too, so applications keep showing the
+// job they were made for after it is deleted.
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type CandidateProfileRepository struct{}
+
+func NewCandidateProfileRepository() *CandidateProfileRepository {
+	return &CandidateProfileRepository{}
+}
+
+func (r *CandidateProfileRepository) Create(profile *domain.CandidateProfile) error {
+	return database.DB.Create(profile).Error
+}
+
+// Replace saves the profile and swaps its experience and education entries
+// for the ones it currently holds.
+func (r *CandidateProfileRepository) Replace(profile *domain.CandidateProfile) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("profile_id = ?", profile.ID).Delete(&domain.WorkExperience{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("profile_id = ?", profile.ID).Delete(&domain.Education{}).Error; err != nil {
+			return err
+		}
+		for i := range profile.Experiences {
+			profile.Experiences[i].ID = 0
+		}
+		for i := range profile.Education {
+			profile.Education[i].ID = 0
+		}
+		return tx.Session(&gorm.Session{FullSaveAssociations: true}).Save(profile).Error
+	})
+}
+
+func (r *CandidateProfileRepository) Delete(profile *domain.CandidateProfile) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("profile_id = ?", profile.ID).Delete(&domain.WorkExperience{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("profile_id = ?", profile.ID).Delete(&domain.Education{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(profile).Error
+	})
+}
+
+func (r *CandidateProfileRepository) FindByUserID(userID uint) (*domain.CandidateProfile, error) {
+	var profile domain.CandidateProfile
+	err := r.withEntries(database.DB).Where("user_id = ?", userID).First(&profile).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrProfileNotFound
+	}
+	return &profile, err
+}
+
+func (r *CandidateProfileRepository) FindByUserIDs(userIDs []uint) ([]domain.CandidateProfile, error) {
+	var profiles []domain.CandidateProfile
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+	err := r.withEntries(database.DB).Where("user_id IN ?", userIDs).Find(&profiles).Error
+	return profiles, err
+}
+
+func (r *CandidateProfileRepository) withEntries(db *gorm.DB) *gorm.DB {
+	return db.Preload("Experiences", orderByPosition).Preload("Education", orderByPosition)
+}
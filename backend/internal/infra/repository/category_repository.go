@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"github.com/helberthlucas14/internal/infra/database"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type CategoryRepository struct{}
+
+func NewCategoryRepository() *CategoryRepository {
+	return &CategoryRepository{}
+}
+
+func (r *CategoryRepository) Create(category *domain.Category) error {
+	return database.DB.Create(category).Error
+}
+
+func (r *CategoryRepository) Update(category *domain.Category) error {
+	return database.DB.Save(category).Error
+}
+
+func (r *CategoryRepository) Delete(id uint) error {
+	return database.DB.Delete(&domain.Category{}, id).Error
+}
+
+func (r *CategoryRepository) FindAll() ([]domain.Category, error) {
+	var categories []domain.Category
+	err := database.DB.Order("name asc").Find(&categories).Error
+	return categories, err
+}
+
+func (r *CategoryRepository) FindByID(id uint) (*domain.Category, error) {
+	var category domain.Category
+	err := database.DB.First(&category, id).Error
+	return &category, err
+}
+
+// CountJobs counts the jobs filed under the category, including soft-deleted
+// ones that still reference it.
+func (r *CategoryRepository) CountJobs(id uint) (int64, error) {
+	var count int64
+	err := database.DB.Unscoped().Model(&domain.Job{}).Where("category_id = ?", id).Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InterviewRepository struct{}
+
+func NewInterviewRepository() *InterviewRepository {
+	return &InterviewRepository{}
+}
+
+// Create stores an interview together with its interviewers.
+func (r *InterviewRepository) Create(interview *domain.Interview) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(interview).Error; err != nil {
+			return err
+		}
+		return createInterviewers(tx, interview)
+	})
+}
+
+// Update saves an interview and replaces its interviewers in one transaction.
+// A slot booked for the interview is freed when the interview moves off it.
+func (r *InterviewRepository) Update(interview *domain.Interview) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(interview).Error; err != nil {
+			return err
+		}
+		err := tx.Model(&domain.InterviewSlot{}).
+			Where("interview_id = ? AND (starts_at <> ? OR ends_at <> ?)", interview.ID, interview.StartsAt, interview.EndsAt).
+			Update("interview_id", nil).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("interview_id = ?", interview.ID).Delete(&domain.InterviewParticipant{}).Error; err != nil {
+			return err
+		}
+		return createInterviewers(tx, interview)
+	})
+}
+
+// Cancel saves a canceled interview and frees the slot it was booked on, so
+// the slot can be booked again.
+func (r *InterviewRepository) Cancel(interview *domain.Interview) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(interview).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.InterviewSlot{}).Where("interview_id = ?", interview.ID).Update("interview_id", nil).Error
+	})
+}
+
+func (r *InterviewRepository) FindByID(id uint) (*domain.Interview, error) {
+	var interview domain.Interview
+	err := withInterviewDetails(database.DB).First(&interview, id).Error
+	return &interview, err
+}
+
+// FindByApplicationID returns the interviews of an application, soonest
+// first.
+func (r *InterviewRepository) FindByApplicationID(appID uint) ([]domain.Interview, error) {
+	var interviews []domain.Interview
+	err := withInterviewDetails(database.DB).Where("application_id = ?", appID).Order("starts_at asc, id asc").Find(&interviews).Error
+	return interviews, err
+}
+
+func withInterviewDetails(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Application").
+		Preload("Application.Job", withDeleted).
+		Preload("Application.Job.Recruiter").
+		Preload("Application.Candidate").
+		Preload("Interviewers.User").
+		Preload("ScheduledBy")
+}
+
+func createInterviewers(tx *gorm.DB, interview *domain.Interview) error {
+	if len(interview.Interviewers) == 0 {
+		return nil
+	}
+	for i := range interview.Interviewers {
+		interview.Interviewers[i].ID = 0
+		interview.Interviewers[i].InterviewID = interview.ID
+	}
+	return tx.Omit("User").Create(&interview.Interviewers).Error
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InterviewSlotRepository struct{}
+
+func NewInterviewSlotRepository() *InterviewSlotRepository {
+	return &InterviewSlotRepository{}
+}
+
+func (r *InterviewSlotRepository) CreateMany(slots []domain.InterviewSlot) error {
+	if len(slots) == 0 {
+		return nil
+	}
+	return database.DB.Omit(clause.Associations).Create(&slots).Error
+}
+
+// Delete removes a slot nobody has booked yet.
+func (r *InterviewSlotRepository) Delete(slot *domain.InterviewSlot) error {
+	result := database.DB.Where("interview_id IS NULL").Delete(&domain.InterviewSlot{}, slot.ID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("slot has already been booked")
+	}
+	return nil
+}
+
+// Book creates the interview and claims the slot for it in one transaction.
+// The application row is locked so a candidate cannot book two slots at once,
+// and the slot is only claimed while still free, so two candidates racing for
+// it cannot both get it: the loser's interview is rolled back.
+func (r *InterviewSlotRepository) Book(slot *domain.InterviewSlot, interview *domain.Interview) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		var app domain.Application
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&app, interview.ApplicationID).Error; err != nil {
+			return err
+		}
+
+		var booked int64
+		err := tx.Model(&domain.Interview{}).
+			Joins("JOIN interview_slots ON interview_slots.interview_id = interviews.id").
+			Where("interviews.application_id = ? AND interviews.status = ?", interview.ApplicationID, domain.InterviewScheduled).
+			Count(&booked).Error
+		if err != nil {
+			return err
+		}
+		if booked > 0 {
+			return errors.New("an interview is already booked for this application")
+		}
+
+		if err := tx.Omit(clause.Associations).Create(interview).Error; err != nil {
+			return err
+		}
+		if err := createInterviewers(tx, interview); err != nil {
+			return err
+		}
+
+		result := tx.Model(&domain.InterviewSlot{}).
+			Where("id = ? AND interview_id IS NULL", slot.ID).
+			Update("interview_id", interview.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("slot has just been booked, pick another one")
+		}
+		slot.InterviewID = &interview.ID
+		return nil
+	})
+}
+
+func (r *InterviewSlotRepository) FindByID(id uint) (*domain.InterviewSlot, error) {
+	var slot domain.InterviewSlot
+	err := database.DB.Preload("Interviewer").First(&slot, id).Error
+	return &slot, err
+}
+
+// FindUpcomingByJobID lists the slots of a job starting after from, booked or
+// not, soonest first.
+func (r *InterviewSlotRepository) FindUpcomingByJobID(jobID uint, from time.Time) ([]domain.InterviewSlot, error) {
+	var slots []domain.InterviewSlot
+	err := database.DB.Preload("Interviewer").Preload("Interview.Application.Candidate").
+		Where("job_id = ? AND starts_at > ?", jobID, from).
+		Order("starts_at asc, id asc").
+		Find(&slots).Error
+	return slots, err
+}
+
+// CountOverlapping counts the slots of an interviewer, across all jobs, that
+// overlap the given period.
+func (r *InterviewSlotRepository) CountOverlapping(interviewerID uint, startsAt, endsAt time.Time) (int64, error) {
+	var count int64
+	err := database.DB.Model(&domain.InterviewSlot{}).
+		Where("interviewer_id = ? AND starts_at < ? AND ends_at > ?", interviewerID, endsAt, startsAt).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type JobApprovalRepository struct{}
+
+func NewJobApprovalRepository() *JobApprovalRepository {
+	return &JobApprovalRepository{}
+}
+
+// CreateWithJob records a new approval request and moves its job from status
+// from to the job's new status in one transaction. Submitting a job that is no
+// longer in status from, e.g. twice at once, fails.
+func (r *JobApprovalRepository) CreateWithJob(approval *domain.JobApproval, job *domain.Job, from string) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := moveJob(tx, job, from); err != nil {
+			return err
+		}
+		return tx.Create(approval).Error
+	})
+}
+
+// UpdateWithJob stores the decision on a pending approval and moves its job
+// out of PENDING_APPROVAL in one transaction. Deciding an approval that was
+// already decided fails.
+func (r *JobApprovalRepository) UpdateWithJob(approval *domain.JobApproval, job *domain.Job) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.JobApproval{}).
+			Where("id = ? AND status = ?", approval.ID, domain.ApprovalPending).
+			Updates(map[string]interface{}{
+				"status":           approval.Status,
+				"decided_by_id":    approval.DecidedByID,
+				"decision_comment": approval.DecisionComment,
+				"decided_at":       approval.DecidedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("approval request was already decided")
+		}
+		return moveJob(tx, job, domain.JobStatusPendingApproval)
+	})
+}
+
+func (r *JobApprovalRepository) FindPendingByJobID(jobID uint) (*domain.JobApproval, error) {
+	var approval domain.JobApproval
+	err := database.DB.Preload("RequestedBy").
+		Where("job_id = ? AND status = ?", jobID, domain.ApprovalPending).
+		First(&approval).Error
+	return &approval, err
+}
+
+func (r *JobApprovalRepository) FindByJobID(jobID uint) ([]domain.JobApproval, error) {
+	var approvals []domain.JobApproval
+	err := database.DB.Preload("Job").Preload("RequestedBy").Preload("DecidedBy").
+		Where("job_id = ?", jobID).
+		Order("created_at desc").
+		Find(&approvals).Error
+	return approvals, err
+}
+
+// FindByOrganizationIDs lists the approvals of the given organizations, newest
+// first. An empty status lists them all.
+func (r *JobApprovalRepository) FindByOrganizationIDs(orgIDs []uint, status domain.ApprovalStatus, page, limit int) ([]domain.JobApproval, int64, error) {
+	var approvals []domain.JobApproval
+	var total int64
+
+	db := database.DB.Model(&domain.JobApproval{}).Where("organization_id IN ?", orgIDs)
+	if status != "" {
+		db = db.Where("status = ?", status)
+	}
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * limit
+	err := db.Preload("Job").Preload("RequestedBy").Preload("DecidedBy").
+		Limit(limit).Offset(offset).Order("created_at desc").
+		Find(&approvals).Error
+	return approvals, total, err
+}
+
+// moveJob writes the job's status, publication and expiration times and
+// archival, provided the job is still in status from.
+func moveJob(tx *gorm.DB, job *domain.Job, from string) error {
+	result := tx.Model(&domain.Job{}).
+		Where("id = ? AND status = ?", job.ID, from).
+		Updates(map[string]interface{}{"status": job.Status, "publish_at": job.PublishAt, "expires_at": job.ExpiresAt, "archived_at": job.ArchivedAt})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("job status changed, reload it and try again")
+	}
+	return nil
+}
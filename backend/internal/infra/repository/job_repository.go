@@ -1,7 +1,16 @@
 package repository
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
 	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/helberthlucas14/internal/domain"
 )
@@ -20,54 +29,511 @@ func (r *JobRepository) Update(job *domain.Job) error {
 	return database.DB.Save(job).Error
 }
 
-func (r *JobRepository) FindAll(page, limit int, query string, status string) ([]domain.Job, int64, error) {
+// Edit stores an edit of a job in one transaction: the job's fields, then its
+// screening questions and scorecard criteria when given. The job row is locked
+// first, and the edit fails if the status changed meanwhile, so a concurrent
+// change such as a hire closing the job is not overwritten.
+func (r *JobRepository) Edit(edit *domain.JobEdit) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		var current domain.Job
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status").First(&current, edit.Job.ID).Error; err != nil {
+			return err
+		}
+		if current.Status != edit.From {
+			return errors.New("job status changed, reload it and try again")
+		}
+		if err := tx.Omit(clause.Associations).Save(edit.Job).Error; err != nil {
+			return err
+		}
+		if edit.Questions != nil {
+			if err := replaceQuestions(tx, edit.Job.ID, *edit.Questions); err != nil {
+				return err
+			}
+		}
+		if edit.Criteria != nil {
+			if err := replaceCriteria(tx, edit.Job.ID, *edit.Criteria); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Reopen stores a reopening in one transaction, provided the job is still
+// CLOSED.
+func (r *JobRepository) Reopen(reopening *domain.JobReopening) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := moveJob(tx, reopening.Job, domain.JobStatusClosed); err != nil {
+			return err
+		}
+		if reopening.Approval != nil {
+			if err := tx.Create(reopening.Approval).Error; err != nil {
+				return err
+			}
+		}
+		for _, t := range reopening.Restorations {
+			if err := transitionApplication(tx, t.Application, t.Event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *JobRepository) FindAll(page, limit int, filter domain.JobFilter) ([]domain.Job, int64, error) {
 	var jobs []domain.Job
 	var total int64
 
-	db := database.DB.Model(&domain.Job{}).Preload("Recruiter")
-
-	if query != "" {
-		db = db.Where("title LIKE ? OR description LIKE ?", "%"+query+"%", "%"+query+"%")
-	}
+	db := database.DB.Model(&domain.Job{}).Preload("Recruiter").Preload("Category")
 
-	if status != "" {
-		db = db.Where("status = ?", status)
-	}
+	db = applyJobFilter(db, filter)
 
 	if err := db.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	offset := (page - 1) * limit
-	err := db.Limit(limit).Offset(offset).Order("created_at desc").Find(&jobs).Error
+	err := orderJobs(db, filter).Preload("Questions", orderByPosition).Limit(limit).Offset(offset).Find(&jobs).Error
 	return jobs, total, err
 }
 
 func (r *JobRepository) FindByID(id uint) (*domain.Job, error) {
 	var job domain.Job
-	err := database.DB.Preload("Recruiter").First(&job, id).Error
+	err := database.DB.Preload("Recruiter").Preload("Organization").Preload("Category").Preload("Questions", orderByPosition).Preload("Criteria", orderByPosition).First(&job, id).Error
 	return &job, err
 }
 
-func (r *JobRepository) FindByRecruiterID(recruiterID uint, page, limit int, query string, status string) ([]domain.Job, int64, error) {
+// FindDeletedByID finds a soft-deleted job, for restoring it.
+func (r *JobRepository) FindDeletedByID(id uint) (*domain.Job, error) {
+	var job domain.Job
+	err := database.DB.Unscoped().Preload("Organization").Where("deleted_at IS NOT NULL").First(&job, id).Error
+	return &job, err
+}
+
+// Delete soft-deletes the job. Its applications are kept for the candidates'
+// history.
+func (r *JobRepository) Delete(job *domain.Job) error {
+	return database.DB.Delete(job).Error
+}
+
+func (r *JobRepository) Restore(job *domain.Job) error {
+	job.DeletedAt = gorm.DeletedAt{}
+	return database.DB.Unscoped().Model(job).Update("deleted_at", nil).Error
+}
+
+func (r *JobRepository) FindByRecruiterID(recruiterID uint, page, limit int, filter domain.JobFilter) ([]domain.Job, int64, error) {
 	var jobs []domain.Job
 	var total int64
 
-	db := database.DB.Model(&domain.Job{}).Where("recruiter_id = ?", recruiterID).Preload("Recruiter")
+	db := database.DB.Model(&domain.Job{}).
+		Where("organization_id IN (?)", database.DB.Model(&domain.OrganizationMember{}).Select("organization_id").Where("user_id = ?", recruiterID)).
+		Preload("Recruiter").Preload("Category")
 
-	if query != "" {
-		db = db.Where("title LIKE ? OR description LIKE ?", "%"+query+"%", "%"+query+"%")
-	}
-
-	if status != "" {
-		db = db.Where("status = ?", status)
-	}
+	db = applyJobFilter(db, filter)
 
 	if err := db.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	offset := (page - 1) * limit
-	err := db.Limit(limit).Offset(offset).Order("created_at desc").Find(&jobs).Error
+	err := orderJobs(db, filter).Preload("Questions", orderByPosition).Limit(limit).Offset(offset).Find(&jobs).Error
 	return jobs, total, err
 }
+
+// maxFacetValues caps the values returned for open-ended facets such as
+// location and company.
+const maxFacetValues = 20
+
+// Facets counts the jobs matching filter per facet value. Each facet is counted
+// with every filter applied except its own; salary bands are counted in the
+// filter's currency and period, BRL and MONTH unless given.
+func (r *JobRepository) Facets(filter domain.JobFilter) (*domain.JobFacets, error) {
+	facets := &domain.JobFacets{}
+	scope := func(clear func(f *domain.JobFilter)) *gorm.DB {
+		f := filter
+		clear(&f)
+		return applyJobFilter(database.DB.Model(&domain.Job{}), f)
+	}
+
+	groups := []struct {
+		column string
+		clear  func(f *domain.JobFilter)
+		counts *[]domain.FacetCount
+	}{
+		{"location", func(f *domain.JobFilter) { f.Locations = nil }, &facets.Location},
+		{"company", func(f *domain.JobFilter) { f.Companies = nil }, &facets.Company},
+		{"employment_type", func(f *domain.JobFilter) { f.EmploymentTypes = nil }, &facets.EmploymentType},
+		{"seniority", func(f *domain.JobFilter) { f.Seniorities = nil }, &facets.Seniority},
+		{"work_model", func(f *domain.JobFilter) { f.WorkModels = nil }, &facets.WorkModel},
+	}
+	for _, g := range groups {
+		err := scope(g.clear).
+			Where(g.column + " <> ''").
+			Select(g.column + " AS value, count(*) AS count").
+			Group(g.column).
+			Order("count(*) desc, " + g.column).
+			Limit(maxFacetValues).
+			Scan(g.counts).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, band := range domain.SalaryBands {
+		var count int64
+		if err := inSalaryBand(scope(func(f *domain.JobFilter) { f.SalaryBand = ""; *f = f.ScopeSalary() }), band).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		facets.SalaryBand = append(facets.SalaryBand, domain.FacetCount{Value: band.Key, Count: count})
+	}
+
+	now := time.Now()
+	for _, p := range domain.PostedWithinOptions {
+		var count int64
+		if err := scope(func(f *domain.JobFilter) { f.PostedAfter = nil }).
+			Where("jobs.created_at >= ?", now.Add(-p.Window)).
+			Count(&count).Error; err != nil {
+			return nil, err
+		}
+		facets.PostedWithin = append(facets.PostedWithin, domain.FacetCount{Value: p.Key, Count: count})
+	}
+
+	return facets, nil
+}
+
+// replaceQuestions swaps the job's screening questions for the given ones.
+func replaceQuestions(tx *gorm.DB, jobID uint, questions []domain.ScreeningQuestion) error {
+	if err := tx.Where("job_id = ?", jobID).Delete(&domain.ScreeningQuestion{}).Error; err != nil {
+		return err
+	}
+	if len(questions) == 0 {
+		return nil
+	}
+	for i := range questions {
+		questions[i].JobID = jobID
+	}
+	return tx.Create(&questions).Error
+}
+
+// ReplaceCriteria replaces the job's scorecard criteria on its own, see
+// replaceCriteria.
+func (r *JobRepository) ReplaceCriteria(jobID uint, criteria []domain.ScorecardCriterion) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		return replaceCriteria(tx, jobID, criteria)
+	})
+}
+
+// replaceCriteria swaps the job's scorecard criteria for the given ones.
+// Criteria that already have an ID are kept and updated; submitted ratings
+// keep their own copy of each name.
+func replaceCriteria(tx *gorm.DB, jobID uint, criteria []domain.ScorecardCriterion) error {
+	var keep []uint
+	for _, c := range criteria {
+		if c.ID != 0 {
+			keep = append(keep, c.ID)
+		}
+	}
+	stale := tx.Where("job_id = ?", jobID)
+	if len(keep) > 0 {
+		stale = stale.Where("id NOT IN ?", keep)
+	}
+	if err := stale.Delete(&domain.ScorecardCriterion{}).Error; err != nil {
+		return err
+	}
+	for i := range criteria {
+		criteria[i].JobID = jobID
+		if err := tx.Save(&criteria[i]).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PublishDue opens the scheduled jobs whose publication time has come.
+func (r *JobRepository) PublishDue(now time.Time) (int64, error) {
+	result := database.DB.Model(&domain.Job{}).
+		Where("status = ? AND publish_at <= ?", domain.JobStatusScheduled, now).
+		Update("status", domain.JobStatusOpen)
+	return result.RowsAffected, result.Error
+}
+
+// ExpireDue moves jobs past their expiration time to EXPIRED. Their
+// applications are left as they are.
+func (r *JobRepository) ExpireDue(now time.Time) (int64, error) {
+	result := database.DB.Model(&domain.Job{}).
+		Where("status IN ? AND expires_at <= ?", []string{domain.JobStatusScheduled, domain.JobStatusOpen, domain.JobStatusPaused}, now).
+		Update("status", domain.JobStatusExpired)
+	return result.RowsAffected, result.Error
+}
+
+// Hire applies a hire to a job in one transaction. The job row stays locked
+// until it commits, so concurrent hires count each other and cannot exceed the
+// openings or close the job halfway.
+func (r *JobRepository) Hire(hiring *domain.Hiring) (*domain.HiringResult, error) {
+	var result domain.HiringResult
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var job domain.Job
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&job, hiring.JobID).Error; err != nil {
+			return err
+		}
+		if !job.CanBecome(domain.JobStatusClosed) {
+			return errors.New("only OPEN or PAUSED jobs can hire")
+		}
+
+		var hired int64
+		err := tx.Model(&domain.Application{}).Where("job_id = ? AND status = ?", job.ID, domain.StatusHired).Count(&hired).Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if hiring.Application != nil {
+			if !hiring.Finalize && int(hired) >= job.Openings {
+				return fmt.Errorf("all %d openings are already filled, raise openings to hire more", job.Openings)
+			}
+			if hiring.Offer != nil {
+				if err := respondToOffer(tx, hiring.Offer); err != nil {
+					return err
+				}
+			}
+			moved, err := moveApplication(tx, hiring.Application, domain.StatusHired, hiring.HiredStage, domain.EventHired, hiring.ActorID, "", now)
+			if err != nil {
+				return err
+			}
+			if !moved {
+				return errors.New("candidate application is no longer pending")
+			}
+			err = tx.Model(&domain.Offer{}).
+				Where("application_id = ? AND status IN ?", hiring.Application.ID, []domain.OfferStatus{domain.OfferDraft, domain.OfferSent}).
+				Update("status", domain.OfferWithdrawn).Error
+			if err != nil {
+				return err
+			}
+			hired++
+		}
+
+		result = domain.HiringResult{Status: job.Status, Openings: job.Openings, Hired: int(hired)}
+		if !hiring.Finalize && int(hired) < job.Openings {
+			return nil
+		}
+
+		if err := tx.Model(&job).Update("status", domain.JobStatusClosed).Error; err != nil {
+			return err
+		}
+		result.Status, result.Closed = domain.JobStatusClosed, true
+
+		err = tx.Model(&domain.Offer{}).
+			Where("status IN ? AND application_id IN (?)", []domain.OfferStatus{domain.OfferDraft, domain.OfferSent},
+				database.DB.Model(&domain.Application{}).Select("id").Where("job_id = ?", job.ID)).
+			Update("status", domain.OfferWithdrawn).Error
+		if err != nil {
+			return err
+		}
+
+		var pending []domain.Application
+		if err := tx.Preload("Stage").Where("job_id = ? AND status = ?", job.ID, domain.StatusPending).Find(&pending).Error; err != nil {
+			return err
+		}
+		for i := range pending {
+			if _, err := moveApplication(tx, &pending[i], domain.StatusRejected, hiring.RejectedStage, domain.EventRejected, hiring.ActorID, hiring.RejectReason, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// moveApplication moves a pending application to status and stage and records
+// the event, reporting false when the application was no longer pending.
+func moveApplication(tx *gorm.DB, app *domain.Application, status domain.ApplicationStatus, stage *domain.PipelineStage, eventType domain.ApplicationEventType, actorID uint, reason string, now time.Time) (bool, error) {
+	if err := lockStage(tx, &stage.ID); err != nil {
+		return false, err
+	}
+	update := tx.Model(&domain.Application{}).
+		Where("id = ? AND status = ?", app.ID, domain.StatusPending).
+		Updates(map[string]interface{}{
+			"status":              status,
+			"stage_id":            stage.ID,
+			"stage_changed_at":    now,
+			"stage_changed_by_id": actorID,
+		})
+	if update.Error != nil || update.RowsAffected == 0 {
+		return false, update.Error
+	}
+
+	event := &domain.ApplicationEvent{
+		ApplicationID: app.ID,
+		Type:          eventType,
+		ActorID:       &actorID,
+		FromStatus:    domain.StatusPending,
+		ToStatus:      status,
+		ToStage:       stage.Name,
+		Reason:        reason,
+	}
+	if app.Stage != nil {
+		event.FromStage = app.Stage.Name
+	}
+	if err := tx.Create(event).Error; err != nil {
+		return false, err
+	}
+
+	app.Status = status
+	app.StageID = &stage.ID
+	app.Stage = stage
+	app.StageChangedAt = &now
+	app.StageChangedByID = &actorID
+	return true, nil
+}
+
+func applyJobFilter(db *gorm.DB, filter domain.JobFilter) *gorm.DB {
+	switch {
+	case filter.Deleted:
+		db = db.Unscoped().Where("jobs.deleted_at IS NOT NULL")
+	case filter.Archived:
+		db = db.Where("jobs.archived_at IS NOT NULL")
+	default:
+		db = db.Where("jobs.archived_at IS NULL")
+	}
+
+	if filter.Query != "" {
+		db = db.Where("jobs.search_vector @@ "+jobSearchQuery, sql.Named("query", filter.Query))
+	}
+
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.PublishedAt != nil {
+		db = db.Where("status NOT IN ? AND (publish_at IS NULL OR publish_at <= ?)", domain.UnpublishedJobStatuses, *filter.PublishedAt)
+	}
+
+	if filter.SalaryMin != nil || filter.SalaryMax != nil {
+		db = withPublishedSalary(db)
+	}
+	if filter.SalaryMin != nil {
+		db = db.Where("COALESCE(salary_max, salary_min) >= ?", *filter.SalaryMin)
+	}
+	if filter.SalaryMax != nil {
+		db = db.Where("COALESCE(salary_min, salary_max) <= ?", *filter.SalaryMax)
+	}
+	if band, ok := domain.FindSalaryBand(filter.SalaryBand); ok {
+		db = inSalaryBand(db, band)
+	}
+	if filter.Currency != "" {
+		db = db.Where("salary_currency = ?", filter.Currency)
+	}
+	if filter.SalaryPeriod != "" {
+		db = db.Where("salary_period = ?", filter.SalaryPeriod)
+	}
+
+	if len(filter.Categories) > 0 {
+		db = db.Where("category_id IN (?)", database.DB.Model(&domain.Category{}).Select("id").Where("slug IN ?", filter.Categories))
+	}
+	if len(filter.Locations) > 0 {
+		db = db.Where("location IN ?", filter.Locations)
+	}
+	if len(filter.Companies) > 0 {
+		db = db.Where("company IN ?", filter.Companies)
+	}
+	if len(filter.EmploymentTypes) > 0 {
+		db = db.Where("employment_type IN ?", filter.EmploymentTypes)
+	}
+	if len(filter.Seniorities) > 0 {
+		db = db.Where("seniority IN ?", filter.Seniorities)
+	}
+	if len(filter.WorkModels) > 0 {
+		db = db.Where("work_model IN ?", filter.WorkModels)
+	}
+	if filter.PostedAfter != nil {
+		db = db.Where("jobs.created_at >= ?", *filter.PostedAfter)
+	}
+	if filter.Near != nil && filter.RadiusKm != nil {
+		db = withinRadius(db, *filter.Near, *filter.RadiusKm)
+	}
+
+	return db
+}
+
+func withPublishedSalary(db *gorm.DB) *gorm.DB {
+	return db.Where("salary_hidden = ? AND (salary_min IS NOT NULL OR salary_max IS NOT NULL)", false)
+}
+
+// inSalaryBand matches published ranges overlapping [band.Min, band.Max), so a
+// range ending exactly where a band starts is not counted in it.
+func inSalaryBand(db *gorm.DB, band domain.SalaryBand) *gorm.DB {
+	db = withPublishedSalary(db)
+	if band.Min != nil {
+		db = db.Where("COALESCE(salary_max, salary_min) >= ?", *band.Min)
+	}
+	if band.Max != nil {
+		db = db.Where("COALESCE(salary_min, salary_max) < ?", *band.Max)
+	}
+	return db
+}
+
+// kmPerDegree is the length of one degree of latitude.
+const kmPerDegree = domain.EarthRadiusKm * math.Pi / 180
+
+// jobDistance is the great-circle distance in km between a job and the point
+// (@lat, @lng), NULL for jobs without coordinates. LEAST guards acos against
+// rounding slightly above 1 for a job at the point itself.
+var jobDistance = fmt.Sprintf("(%g * acos(LEAST(1, "+
+	"cos(radians(@lat)) * cos(radians(jobs.latitude)) * cos(radians(jobs.longitude) - radians(@lng)) + "+
+	"sin(radians(@lat)) * sin(radians(jobs.latitude)))))", domain.EarthRadiusKm)
+
+// withinRadius keeps jobs at most radiusKm from center. A bounding box on the
+// indexed coordinates narrows the candidates before the exact distance check.
+func withinRadius(db *gorm.DB, center domain.GeoPoint, radiusKm float64) *gorm.DB {
+	dLat := radiusKm / kmPerDegree
+	db = db.Where("jobs.latitude BETWEEN ? AND ?", center.Lat-dLat, center.Lat+dLat)
+	if cos := math.Cos(center.Lat * math.Pi / 180); cos > 0.01 {
+		dLng := radiusKm / (kmPerDegree * cos)
+		if center.Lng-dLng >= -180 && center.Lng+dLng <= 180 {
+			db = db.Where("jobs.longitude BETWEEN ? AND ?", center.Lng-dLng, center.Lng+dLng)
+		}
+	}
+	return db.Where(jobDistance+" <= @radius",
+		sql.Named("lat", center.Lat), sql.Named("lng", center.Lng), sql.Named("radius", radiusKm))
+}
+
+// jobSearchQuery parses a web-search style query (quoted phrases, "or", "-")
+// with both the Portuguese and the English configuration, matching the two
+// lexeme sets stored in jobs.search_vector.
+const jobSearchQuery = "(websearch_to_tsquery('portuguese', @query) || websearch_to_tsquery('english', @query))"
+
+// orderJobs sorts by distance when the filter has a point, then by relevance
+// when it has a query, newest first otherwise. Ranked results also carry
+// highlighted title and description snippets, and located ones their distance,
+// in domain.JobSearchMatch.
+func orderJobs(db *gorm.DB, filter domain.JobFilter) *gorm.DB {
+	columns := []string{"jobs.*"}
+	var args []any
+	var order []string
+
+	if filter.Near != nil {
+		columns = append(columns, jobDistance+" AS distance_km")
+		args = append(args, sql.Named("lat", filter.Near.Lat), sql.Named("lng", filter.Near.Lng))
+		order = append(order, "distance_km asc NULLS LAST")
+	}
+	if filter.Query != "" {
+		columns = append(columns,
+			"ts_rank_cd(jobs.search_vector, "+jobSearchQuery+") AS search_rank",
+			"ts_headline('portuguese', jobs.title, "+jobSearchQuery+", 'HighlightAll=true, StartSel=<mark>, StopSel=</mark>') AS title_highlight",
+			"ts_headline('portuguese', jobs.description, "+jobSearchQuery+", 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=25, MinWords=8') AS description_highlight")
+		args = append(args, sql.Named("query", filter.Query))
+		order = append(order, "search_rank desc")
+	}
+
+	order = append(order, "created_at desc")
+	if len(args) == 0 {
+		return db.Order(strings.Join(order, ", "))
+	}
+	return db.Select(strings.Join(columns, ", "), args...).Order(strings.Join(order, ", "))
+}
+
+func orderByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc")
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OfferRepository struct{}
+
+func NewOfferRepository() *OfferRepository {
+	return &OfferRepository{}
+}
+
+// Create stores a new offer unless its application already has an open one.
+// The application row is locked so two offers cannot be opened at once.
+func (r *OfferRepository) Create(offer *domain.Offer) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		var app domain.Application
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&app, offer.ApplicationID).Error; err != nil {
+			return err
+		}
+
+		var open int64
+		err := tx.Model(&domain.Offer{}).
+			Where("application_id = ? AND status IN ?", offer.ApplicationID, []domain.OfferStatus{domain.OfferDraft, domain.OfferSent}).
+			Count(&open).Error
+		if err != nil {
+			return err
+		}
+		if open > 0 {
+			return errors.New("this application already has an open offer")
+		}
+
+		return tx.Omit(clause.Associations).Create(offer).Error
+	})
+}
+
+func (r *OfferRepository) Update(offer *domain.Offer) error {
+	return database.DB.Omit(clause.Associations).Save(offer).Error
+}
+
+// Respond records the candidate's answer to a sent offer. Answering an offer
+// that was already answered or has expired, e.g. twice at once, fails.
+func (r *OfferRepository) Respond(offer *domain.Offer) error {
+	return respondToOffer(database.DB, offer)
+}
+
+func respondToOffer(tx *gorm.DB, offer *domain.Offer) error {
+	result := tx.Model(&domain.Offer{}).
+		Where("id = ? AND status = ? AND expires_at > ?", offer.ID, domain.OfferSent, time.Now()).
+		Updates(map[string]interface{}{
+			"status":         offer.Status,
+			"responded_at":   offer.RespondedAt,
+			"decline_reason": offer.DeclineReason,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("offer is no longer awaiting an answer")
+	}
+	return nil
+}
+
+func (r *OfferRepository) FindByID(id uint) (*domain.Offer, error) {
+	var offer domain.Offer
+	err := withOfferDetails(database.DB).First(&offer, id).Error
+	return &offer, err
+}
+
+// FindByApplicationID returns the offers of an application, newest first.
+func (r *OfferRepository) FindByApplicationID(appID uint) ([]domain.Offer, error) {
+	var offers []domain.Offer
+	err := withOfferDetails(database.DB).Where("application_id = ?", appID).Order("created_at desc, id desc").Find(&offers).Error
+	return offers, err
+}
+
+// Expire moves a sent offer past its ExpiresAt to EXPIRED. An offer answered
+// or expired meanwhile is left as it is.
+func (r *OfferRepository) Expire(offer *domain.Offer, now time.Time) error {
+	result := database.DB.Model(&domain.Offer{}).
+		Where("id = ? AND status = ? AND expires_at <= ?", offer.ID, domain.OfferSent, now).
+		Update("status", domain.OfferExpired)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		offer.Status = domain.OfferExpired
+	}
+	return nil
+}
+
+// ExpireDue moves the sent offers past their ExpiresAt to EXPIRED.
+func (r *OfferRepository) ExpireDue(now time.Time) (int64, error) {
+	result := database.DB.Model(&domain.Offer{}).
+		Where("status = ? AND expires_at <= ?", domain.OfferSent, now).
+		Update("status", domain.OfferExpired)
+	return result.RowsAffected, result.Error
+}
+
+func withOfferDetails(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Application").
+		Preload("Application.Job", withDeleted).
+		Preload("Application.Job.Organization").
+		Preload("Application.Candidate").
+		Preload("CreatedBy")
+}
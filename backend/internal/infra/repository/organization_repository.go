@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type OrganizationRepository struct{}
+
+func NewOrganizationRepository() *OrganizationRepository {
+	return &OrganizationRepository{}
+}
+
+func (r *OrganizationRepository) CreateWithOwner(org *domain.Organization, ownerID uint) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(org).Error; err != nil {
+			return err
+		}
+		return tx.Create(&domain.OrganizationMember{
+			OrganizationID: org.ID,
+			UserID:         ownerID,
+			Role:           domain.OrgRoleOwner,
+		}).Error
+	})
+}
+
+func (r *OrganizationRepository) FindByID(id uint) (*domain.Organization, error) {
+	var org domain.Organization
+	err := database.DB.First(&org, id).Error
+	return &org, err
+}
+
+func (r *OrganizationRepository) FindByUserID(userID uint) ([]domain.OrganizationMember, error) {
+	var members []domain.OrganizationMember
+	err := database.DB.Preload("Organization").
+		Joins("JOIN organizations ON organizations.id = organization_members.organization_id AND organizations.deleted_at IS NULL").
+		Where("organization_members.user_id = ?", userID).
+		Order("organization_members.created_at asc").
+		Find(&members).Error
+	return members, err
+}
+
+func (r *OrganizationRepository) FindMember(orgID, userID uint) (*domain.OrganizationMember, error) {
+	var member domain.OrganizationMember
+	err := database.DB.Where("organization_id = ? AND user_id = ?", orgID, userID).First(&member).Error
+	return &member, err
+}
+
+func (r *OrganizationRepository) FindMembers(orgID uint) ([]domain.OrganizationMember, error) {
+	var members []domain.OrganizationMember
+	err := database.DB.Preload("User").Where("organization_id = ?", orgID).Order("created_at asc").Find(&members).Error
+	return members, err
+}
+
+func (r *OrganizationRepository) RemoveMember(orgID, userID uint) error {
+	return database.DB.Where("organization_id = ? AND user_id = ?", orgID, userID).Delete(&domain.OrganizationMember{}).Error
+}
+
+func (r *OrganizationRepository) CountOwners(orgID uint) (int64, error) {
+	var count int64
+	err := database.DB.Model(&domain.OrganizationMember{}).
+		Where("organization_id = ? AND role = ?", orgID, domain.OrgRoleOwner).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *OrganizationRepository) CreateInvitation(invitation *domain.OrganizationInvitation) error {
+	return database.DB.Create(invitation).Error
+}
+
+func (r *OrganizationRepository) UpdateInvitation(invitation *domain.OrganizationInvitation) error {
+	return database.DB.Save(invitation).Error
+}
+
+// AcceptInvitation flips a pending invitation to ACCEPTED and adds the user to
+// the organization in one transaction. Accepting the same invitation twice fails.
+func (r *OrganizationRepository) AcceptInvitation(invitation *domain.OrganizationInvitation, userID uint) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&domain.OrganizationInvitation{}).
+			Where("id = ? AND status = ?", invitation.ID, domain.InvitationPending).
+			Updates(map[string]interface{}{"status": domain.InvitationAccepted, "accepted_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("invitation is no longer pending")
+		}
+
+		invitation.Status = domain.InvitationAccepted
+		invitation.AcceptedAt = &now
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.OrganizationMember{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         userID,
+			Role:           domain.OrgRoleRecruiter,
+		}).Error
+	})
+}
+
+func (r *OrganizationRepository) FindInvitationByID(id uint) (*domain.OrganizationInvitation, error) {
+	var invitation domain.OrganizationInvitation
+	err := database.DB.First(&invitation, id).Error
+	return &invitation, err
+}
+
+func (r *OrganizationRepository) FindInvitationByHash(hash string) (*domain.OrganizationInvitation, error) {
+	var invitation domain.OrganizationInvitation
+	err := database.DB.Preload("Organization").Where("token_hash = ?", hash).First(&invitation).Error
+	return &invitation, err
+}
+
+func (r *OrganizationRepository) FindPendingInvitations(orgID uint) ([]domain.OrganizationInvitation, error) {
+	var invitations []domain.OrganizationInvitation
+	err := database.DB.Where("organization_id = ? AND status = ?", orgID, domain.InvitationPending).
+		Order("created_at desc").
+		Find(&invitations).Error
+	return invitations, err
+}
+
+func (r *OrganizationRepository) Update(org *domain.Organization) error {
+	return database.DB.Save(org).Error
+}
+
+func (r *OrganizationRepository) UpdateMember(member *domain.OrganizationMember) error {
+	return database.DB.Model(member).Update("role", member.Role).Error
+}
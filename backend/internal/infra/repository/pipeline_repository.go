@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type PipelineRepository struct{}
+
+func NewPipelineRepository() *PipelineRepository {
+	return &PipelineRepository{}
+}
+
+func (r *PipelineRepository) FindByJobID(jobID uint) ([]domain.PipelineStage, error) {
+	var stages []domain.PipelineStage
+	err := database.DB.Where("job_id = ?", jobID).Order("position asc").Find(&stages).Error
+	return stages, err
+}
+
+func (r *PipelineRepository) FindByOrganizationID(orgID uint) ([]domain.PipelineStage, error) {
+	var stages []domain.PipelineStage
+	err := database.DB.Where("organization_id = ? AND job_id IS NULL", orgID).Order("position asc").Find(&stages).Error
+	return stages, err
+}
+
+func (r *PipelineRepository) FindByID(id uint) (*domain.PipelineStage, error) {
+	var stage domain.PipelineStage
+	err := database.DB.First(&stage, id).Error
+	return &stage, err
+}
+
+// CreateDefaultStages stores the default pipeline of an organization unless
+// it already has one. The organization row is locked so concurrent first
+// calls create a single pipeline; the one that is kept is returned.
+func (r *PipelineRepository) CreateDefaultStages(orgID uint, stages []domain.PipelineStage) ([]domain.PipelineStage, error) {
+	err := database.DB.Transaction(func(tx *gorm.DB) error {
+		var org domain.Organization
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&org, orgID).Error; err != nil {
+			return err
+		}
+
+		var existing []domain.PipelineStage
+		if err := tx.Where("organization_id = ? AND job_id IS NULL", orgID).Order("position asc").Find(&existing).Error; err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			stages = existing
+			return nil
+		}
+
+		for i := range stages {
+			if err := tx.Save(&stages[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stages, nil
+}
+
+// SaveStages stores a pipeline and deletes the stages in removeIDs in one
+// transaction. The removed stages are locked before they are checked, and
+// moves lock their target stage, so no application can enter a stage between
+// the check and its deletion; a removed stage that still holds applications
+// fails the save.
+func (r *PipelineRepository) SaveStages(stages []domain.PipelineStage, removeIDs []uint) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if len(removeIDs) > 0 {
+			var removed []domain.PipelineStage
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&removed, removeIDs).Error; err != nil {
+				return err
+			}
+			for _, stage := range removed {
+				if err := ensureStageEmpty(tx, stage, "stage_id = ?", stage.ID); err != nil {
+					return err
+				}
+			}
+			if err := tx.Delete(&domain.PipelineStage{}, removeIDs).Error; err != nil {
+				return err
+			}
+		}
+		for i := range stages {
+			if err := tx.Save(&stages[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ensureStageEmpty fails when applications matching query are in stage.
+func ensureStageEmpty(tx *gorm.DB, stage domain.PipelineStage, query string, args ...interface{}) error {
+	var count int64
+	if err := tx.Model(&domain.Application{}).Where(query, args...).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("stage %q still has applications", stage.Name)
+	}
+	return nil
+}
+
+// lockStage takes a shared lock on the stage an application is entering, so
+// the stage cannot be deleted until the application is stored. Entering a
+// stage that no longer exists fails.
+func lockStage(tx *gorm.DB, stageID *uint) error {
+	if stageID == nil {
+		return nil
+	}
+	var stage domain.PipelineStage
+	err := tx.Clauses(clause.Locking{Strength: "SHARE"}).Select("id").First(&stage, *stageID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New("stage no longer exists, reload the pipeline and try again")
+	}
+	return err
+}
+
+// CreateJobStages creates a job's own stages and carries its applications
+// over in one transaction. The current stages are locked first so no
+// application can move into a dropped stage before it is checked.
+func (r *PipelineRepository) CreateJobStages(jobID uint, stages []domain.PipelineStage, moves []domain.StageMove) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		fromIDs := make([]uint, len(moves))
+		for i, move := range moves {
+			fromIDs[i] = move.From.ID
+		}
+		var locked []domain.PipelineStage
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Find(&locked, fromIDs).Error; err != nil {
+			return err
+		}
+
+		for _, move := range moves {
+			if move.To != nil {
+				continue
+			}
+			if err := ensureStageEmpty(tx, move.From, "job_id = ? AND stage_id = ?", jobID, move.From.ID); err != nil {
+				return err
+			}
+		}
+
+		for i := range stages {
+			if err := tx.Save(&stages[i]).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, move := range moves {
+			if move.To == nil {
+				continue
+			}
+			err := tx.Model(&domain.Application{}).
+				Where("job_id = ? AND stage_id = ?", jobID, move.From.ID).
+				Update("stage_id", move.To.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
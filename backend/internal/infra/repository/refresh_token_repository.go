@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/helberthlucas14/internal/infra/database"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type RefreshTokenRepository struct{}
+
+func NewRefreshTokenRepository() *RefreshTokenRepository {
+	return &RefreshTokenRepository{}
+}
+
+func (r *RefreshTokenRepository) Create(token *domain.RefreshToken) error {
+	return database.DB.Create(token).Error
+}
+
+func (r *RefreshTokenRepository) FindByHash(hash string) (*domain.RefreshToken, error) {
+	var token domain.RefreshToken
+	err := database.DB.Where("token_hash = ?", hash).First(&token).Error
+	return &token, err
+}
+
+// Revoke marks a single token as revoked. It reports false when the token was
+// already revoked, which lets callers detect concurrent reuse.
+func (r *RefreshTokenRepository) Revoke(id uint) (bool, error) {
+	result := database.DB.Model(&domain.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *RefreshTokenRepository) RevokeSession(sessionID string) error {
+	return database.DB.Model(&domain.RefreshToken{}).
+		Where("session_id = ? AND revoked_at IS NULL", sessionID).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *RefreshTokenRepository) RevokeAllForUser(userID uint) error {
+	return database.DB.Model(&domain.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *RefreshTokenRepository) IsSessionActive(sessionID string) (bool, error) {
+	var count int64
+	err := database.DB.Model(&domain.RefreshToken{}).
+		Where("session_id = ? AND revoked_at IS NULL AND expires_at > ?", sessionID, time.Now()).
+		Count(&count).Error
+	return count > 0, err
+}
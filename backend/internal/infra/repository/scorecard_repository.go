@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/infra/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ScorecardRepository struct{}
+
+func NewScorecardRepository() *ScorecardRepository {
+	return &ScorecardRepository{}
+}
+
+// Save stores a new scorecard or replaces an existing one together with its
+// ratings in one transaction.
+func (r *ScorecardRepository) Save(scorecard *domain.Scorecard) error {
+	return database.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(scorecard).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("scorecard_id = ?", scorecard.ID).Delete(&domain.ScorecardRating{}).Error; err != nil {
+			return err
+		}
+		for i := range scorecard.Ratings {
+			scorecard.Ratings[i].ID = 0
+			scorecard.Ratings[i].ScorecardID = scorecard.ID
+		}
+		return tx.Create(&scorecard.Ratings).Error
+	})
+}
+
+// FindByApplicationIDs returns every scorecard of the given applications,
+// oldest first, with their interviewers and ratings.
+func (r *ScorecardRepository) FindByApplicationIDs(appIDs []uint) ([]domain.Scorecard, error) {
+	var scorecards []domain.Scorecard
+	if len(appIDs) == 0 {
+		return scorecards, nil
+	}
+	err := database.DB.
+		Preload("Interviewer").
+		Preload("Ratings", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id asc")
+		}).
+		Where("application_id IN ?", appIDs).
+		Order("created_at asc, id asc").
+		Find(&scorecards).Error
+	return scorecards, err
+}
@@ -16,6 +16,10 @@ func (r *UserRepository) Create(user *domain.User) error {
 	return database.DB.Create(user).Error
 }
 
+func (r *UserRepository) Update(user *domain.User) error {
+	return database.DB.Save(user).Error
+}
+
 func (r *UserRepository) FindByEmail(email string) (*domain.User, error) {
 	var user domain.User
 	err := database.DB.Where("email = ?", email).First(&user).Error
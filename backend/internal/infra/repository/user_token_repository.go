@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/helberthlucas14/internal/infra/database"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+type UserTokenRepository struct{}
+
+func NewUserTokenRepository() *UserTokenRepository {
+	return &UserTokenRepository{}
+}
+
+func (r *UserTokenRepository) Create(token *domain.UserToken) error {
+	return database.DB.Create(token).Error
+}
+
+func (r *UserTokenRepository) FindByHash(hash string, purpose domain.TokenPurpose) (*domain.UserToken, error) {
+	var token domain.UserToken
+	err := database.DB.Where("token_hash = ? AND purpose = ?", hash, purpose).First(&token).Error
+	return &token, err
+}
+
+// MarkUsed consumes a token. It reports false when the token had already been
+// used, so a token can never be redeemed twice even under concurrent requests.
+func (r *UserTokenRepository) MarkUsed(id uint) (bool, error) {
+	result := database.DB.Model(&domain.UserToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *UserTokenRepository) InvalidateForUser(userID uint, purpose domain.TokenPurpose) error {
+	return database.DB.Model(&domain.UserToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", time.Now()).Error
+}
@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Every runs task right away and then every interval until ctx is done, so
+// work that fell due while the process was down is caught up on startup.
+// Failures are logged and retried on the next tick.
+func Every(ctx context.Context, interval time.Duration, name string, task func(now time.Time) error) {
+	log.Printf("Scheduler: running %s every %s", name, interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := task(time.Now()); err != nil {
+			log.Printf("Scheduler: %s failed: %v", name, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidSignature = errors.New("invalid or expired download link")
+
+// LocalStorage keeps files on disk. Its signed URLs point back at the API's
+// /files route, which checks the HMAC before serving the file.
+type LocalStorage struct {
+	dir     string
+	baseURL string
+	key     []byte
+}
+
+func NewLocalStorage(dir, baseURL, signingKey string) *LocalStorage {
+	return &LocalStorage{dir: dir, baseURL: strings.TrimRight(baseURL, "/"), key: []byte(signingKey)}
+}
+
+func (s *LocalStorage) Put(key string, data []byte, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+func (s *LocalStorage) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) SignedURL(key, filename string, ttl time.Duration) (string, error) {
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+
+	query := url.Values{}
+	query.Set("name", filename)
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(key, filename, expires))
+
+	return s.baseURL + "/files/" + key + "?" + query.Encode(), nil
+}
+
+// Open checks a link produced by SignedURL and returns the file path and the
+// Content-Disposition header to serve it with.
+func (s *LocalStorage) Open(key, filename, expires, signature string) (string, string, error) {
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > unix {
+		return "", "", ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.sign(key, filename, expires))) {
+		return "", "", ErrInvalidSignature
+	}
+
+	path, err := s.path(key)
+	if err != nil {
+		return "", "", err
+	}
+	return path, contentDisposition(filename), nil
+}
+
+func (s *LocalStorage) sign(key, filename, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(key + "\n" + filename + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" {
+		return "", errors.New("invalid storage key")
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"log"
+	"mime"
+
+	"github.com/helberthlucas14/internal/config"
+	"github.com/helberthlucas14/internal/domain"
+)
+
+// NewStorage picks the file backend configured by STORAGE_DRIVER. "s3" talks
+// to any S3-compatible service (AWS, MinIO, R2...); anything else keeps files
+// on the local disk under STORAGE_DIR.
+func NewStorage(cfg *config.Config) domain.FileStorage {
+	switch cfg.StorageDriver {
+	case "s3":
+		log.Println("Storage: using S3 bucket", cfg.S3Bucket, "at", cfg.S3Endpoint)
+		return NewS3Storage(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKey, cfg.S3SecretKey, cfg.S3PathStyle)
+	default:
+		log.Println("Storage: writing files to", cfg.StorageDir)
+		return NewLocalStorage(cfg.StorageDir, cfg.PublicAPIURL, cfg.StorageSigningKey)
+	}
+}
+
+func contentDisposition(filename string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+}
@@ -1,16 +1,24 @@
 package web
 
 import (
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
 	"net/http"
 	"strconv"
+	"time"
 
-	"github.com/helberthlucas14/internal/domain"
 	"github.com/helberthlucas14/internal/dto"
 	"github.com/helberthlucas14/internal/usecase"
 
 	"github.com/gin-gonic/gin"
 )
 
+// maxApplyRequestSize bounds the whole multipart body: two attachments at the
+// use case's 5 MB limit plus form overhead.
+const maxApplyRequestSize = 11 << 20
+
 type ApplicationHandler struct {
 	appUseCase *usecase.ApplicationUseCase
 }
@@ -21,24 +29,22 @@ func NewApplicationHandler(appUseCase *usecase.ApplicationUseCase) *ApplicationH
 
 // ApplyJob godoc
 // @Summary Apply for a job
-// @Description Apply for a job as a candidate
+// @Description Apply for a job as a candidate (verified email required). The resume and optional cover letter must be PDF or DOCX files of at most 5 MB. Answers failing a REJECT knockout rule reject the application immediately; FLAG rules only mark it for review.
 // @Tags applications
-// @Accept json
+// @Accept multipart/form-data
 // @Produce json
 // @Param id path int true "Job ID"
+// @Param resume formData file true "Resume (PDF or DOCX)"
+// @Param cover_letter formData file false "Cover letter (PDF or DOCX)"
+// @Param answers formData string false "Screening answers as a JSON array, e.g. [{\"question_id\":1,\"value\":\"yes\"}]"
 // @Security BearerAuth
 // @Success 201 {object} dto.ApplyJobOutputDTO
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Router /jobs/{id}/apply [post]
 func (h *ApplicationHandler) ApplyJob(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleCandidate {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only candidates can apply"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
@@ -49,13 +55,35 @@ func (h *ApplicationHandler) ApplyJob(c *gin.Context) {
 		return
 	}
 
-	candidateID := c.GetUint("user_id")
-	app, err := h.appUseCase.Apply(dto.ApplyJobInputDTO{
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxApplyRequestSize)
+
+	resume, err := formFile(c, "resume")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	coverLetter, err := formFile(c, "cover_letter")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	var answers []dto.ScreeningAnswerInputDTO
+	if raw := c.PostForm("answers"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &answers); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "answers must be a JSON array"})
+			return
+		}
+	}
+
+	app, err := h.appUseCase.Apply(subject, dto.ApplyJobInputDTO{
 		JobID:       uint(jobID),
-		CandidateID: candidateID,
+		Resume:      resume,
+		CoverLetter: coverLetter,
+		Answers:     answers,
 	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -74,27 +102,20 @@ func (h *ApplicationHandler) ApplyJob(c *gin.Context) {
 // @Success 200 {object} dto.PaginatedApplicationsOutputDTO
 // @Router /applications [get]
 func (h *ApplicationHandler) MyApplications(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleCandidate {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only candidates can view their applications"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 
-	candidateID := c.GetUint("user_id")
-	apps, err := h.appUseCase.GetMyApplications(candidateID, dto.PaginationInputDTO{
+	apps, err := h.appUseCase.GetMyApplications(subject, dto.PaginationInputDTO{
 		Page:  page,
 		Limit: limit,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		c.JSON(errorStatus(err, http.StatusInternalServerError), ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -103,7 +124,7 @@ func (h *ApplicationHandler) MyApplications(c *gin.Context) {
 
 // GetJobApplications godoc
 // @Summary Get job applications
-// @Description List all applications for a specific job (Recruiter only)
+// @Description List all applications for a specific job with screening answers, attachments, profile summaries, the recruiter note threads the caller may read and scorecard counts (members of the job's organization only). The average score of an application is only shown once the caller has submitted their own scorecard for it, and sorting and score filters likewise only use the average of applications the caller has scored; the others sort last and are left out by score filters.
 // @Tags applications
 // @Accept json
 // @Produce json
@@ -111,18 +132,17 @@ func (h *ApplicationHandler) MyApplications(c *gin.Context) {
 // @Param page query int false "Page number"
 // @Param limit query int false "Items per page"
 // @Param status query string false "Filter by status (e.g., PENDING)"
+// @Param min_score query number false "Only applications the caller scored whose average score is at least this"
+// @Param max_score query number false "Only applications the caller scored whose average score is at most this"
+// @Param sort query string false "score_desc or score_asc; newest first by default"
 // @Security BearerAuth
 // @Success 200 {object} dto.PaginatedApplicationsOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Router /jobs/{id}/applications [get]
 func (h *ApplicationHandler) GetJobApplications(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleRecruiter {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only recruiters can view job applications"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
@@ -135,15 +155,28 @@ func (h *ApplicationHandler) GetJobApplications(c *gin.Context) {
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	status := c.Query("status")
-
-	apps, err := h.appUseCase.GetJobApplications(uint(jobID), dto.PaginationInputDTO{
+	input := dto.JobApplicationsInputDTO{
 		Page:   page,
 		Limit:  limit,
-		Status: status,
-	})
+		Status: c.Query("status"),
+		Sort:   c.Query("sort"),
+	}
+	for param, dst := range map[string]**float64{"min_score": &input.MinScore, "max_score": &input.MaxScore} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid " + param})
+			return
+		}
+		*dst = &value
+	}
+
+	apps, err := h.appUseCase.GetJobApplications(subject, uint(jobID), input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -157,18 +190,13 @@ func (h *ApplicationHandler) GetJobApplications(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Application ID"
+// @Param request body CancelApplicationRequest false "Cancel Application Request"
 // @Security BearerAuth
 // @Success 200 {object} map[string]string
 // @Router /applications/{id}/cancel [patch]
 func (h *ApplicationHandler) CancelApplication(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleCandidate {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only candidates can cancel applications"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
@@ -179,12 +207,856 @@ func (h *ApplicationHandler) CancelApplication(c *gin.Context) {
 		return
 	}
 
-	candidateID := c.GetUint("user_id")
-	err = h.appUseCase.CancelApplication(uint(appID), candidateID)
+	// The body is optional: a missing reason is fine, malformed JSON is not.
+	var req CancelApplicationRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	err = h.appUseCase.CancelApplication(subject, uint(appID), dto.CancelApplicationInputDTO{
+		Reason: req.Reason,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Application canceled successfully"})
+}
+
+// MoveApplication godoc
+// @Summary Move an application to another pipeline stage
+// @Description Move a pending application forward in its job's pipeline, or reject it (members of the job's organization only). Hiring goes through the finalize endpoint.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param request body MoveApplicationRequest true "Move Application Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.ApplyJobOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/move [post]
+func (h *ApplicationHandler) MoveApplication(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	var req MoveApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Application canceled successfully"})
+	app, err := h.appUseCase.MoveApplication(subject, uint(appID), dto.MoveApplicationInputDTO{
+		StageID: req.StageID,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, app)
+}
+
+// GetTimeline godoc
+// @Summary Get the timeline of an application
+// @Description List every status and stage change of an application, oldest first (the candidate who applied or members of the job's organization only). Candidates do not see who made other changes or why.
+// @Tags applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.ApplicationEventOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/timeline [get]
+func (h *ApplicationHandler) GetTimeline(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	events, err := h.appUseCase.GetTimeline(subject, uint(appID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// GetNotes godoc
+// @Summary Get the notes on an application
+// @Description List the recruiter note threads of an application, oldest first, with replies, mentions and edit history (members of the job's organization only). Private threads are only listed for recruiters who wrote in them or were mentioned. Candidates never see notes.
+// @Tags applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.ApplicationNoteOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/notes [get]
+func (h *ApplicationHandler) GetNotes(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	notes, err := h.appUseCase.GetNotes(subject, uint(appID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, notes)
+}
+
+// AddNote godoc
+// @Summary Add a note to an application
+// @Description Start a note thread on an application, or reply to one with parent_id (members of the job's organization only). Replies share the visibility of their thread. Mentioned users must be members of the job's organization and are notified by email.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param request body CreateNoteRequest true "Create Note Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.ApplicationNoteOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/notes [post]
+func (h *ApplicationHandler) AddNote(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	var req CreateNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	note, err := h.appUseCase.AddNote(subject, dto.CreateNoteInputDTO{
+		ApplicationID: uint(appID),
+		ParentID:      req.ParentID,
+		Body:          req.Body,
+		Private:       req.Private,
+		MentionIDs:    req.MentionIDs,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, note)
+}
+
+// UpdateNote godoc
+// @Summary Edit a note
+// @Description Change the text and mentions of a note (its author only). The previous text is kept in the note's revisions and newly mentioned users are notified by email.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param noteId path int true "Note ID"
+// @Param request body UpdateNoteRequest true "Update Note Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.ApplicationNoteOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/notes/{noteId} [patch]
+func (h *ApplicationHandler) UpdateNote(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+	noteID, err := strconv.Atoi(c.Param("noteId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Note ID"})
+		return
+	}
+
+	var req UpdateNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	note, err := h.appUseCase.UpdateNote(subject, dto.UpdateNoteInputDTO{
+		ApplicationID: uint(appID),
+		NoteID:        uint(noteID),
+		Body:          req.Body,
+		MentionIDs:    req.MentionIDs,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, note)
+}
+
+// GetScorecards godoc
+// @Summary Get the scorecards of an application
+// @Description List the job's scorecard criteria and the interviewers' scorecards for an application (members of the job's organization only). Until the caller submits their own scorecard, hidden is true and the other scorecards and the average are left out.
+// @Tags applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Security BearerAuth
+// @Success 200 {object} dto.ApplicationScorecardsOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/scorecards [get]
+func (h *ApplicationHandler) GetScorecards(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	scorecards, err := h.appUseCase.GetScorecards(subject, uint(appID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, scorecards)
+}
+
+// SubmitScorecard godoc
+// @Summary Submit my scorecard for an application
+// @Description Rate every criterion of the job's scorecard from 1 to 5 (members of the job's organization only). Each interviewer has one scorecard per application; submitting again replaces it.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param request body SubmitScorecardRequest true "Submit Scorecard Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.ScorecardOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/scorecard [put]
+func (h *ApplicationHandler) SubmitScorecard(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	var req SubmitScorecardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	scorecard, err := h.appUseCase.SubmitScorecard(subject, dto.SubmitScorecardInputDTO{
+		ApplicationID: uint(appID),
+		Ratings:       req.Ratings,
+		Comment:       req.Comment,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, scorecard)
+}
+
+// GetInterviews godoc
+// @Summary List the interviews of an application
+// @Description List the interviews of an application, soonest first (its candidate or members of the job's organization)
+// @Tags applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.InterviewOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/interviews [get]
+func (h *ApplicationHandler) GetInterviews(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	interviews, err := h.appUseCase.GetInterviews(subject, uint(appID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, interviews)
+}
+
+// ScheduleInterview godoc
+// @Summary Schedule an interview
+// @Description Schedule an interview for a pending application (members of the job's organization only). The candidate and the interviewers receive an iCalendar (.ics) invitation by email. Without interviewer_ids the caller is the only interviewer.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param request body ScheduleInterviewRequest true "Schedule Interview Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.InterviewOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/interviews [post]
+func (h *ApplicationHandler) ScheduleInterview(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	var req ScheduleInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	interview, err := h.appUseCase.ScheduleInterview(subject, dto.ScheduleInterviewInputDTO{
+		ApplicationID:  uint(appID),
+		StartsAt:       req.StartsAt,
+		EndsAt:         req.EndsAt,
+		InterviewerIDs: req.InterviewerIDs,
+		Location:       req.Location,
+		VideoURL:       req.VideoURL,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, interview)
+}
+
+// RescheduleInterview godoc
+// @Summary Reschedule an interview
+// @Description Move a scheduled interview (members of the job's organization only). Attendees receive an update of the same calendar event; interviewers taken off the interview receive a cancellation. Omitted interviewer_ids, location and video_url are kept.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param interviewId path int true "Interview ID"
+// @Param request body RescheduleInterviewRequest true "Reschedule Interview Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.InterviewOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/interviews/{interviewId}/reschedule [post]
+func (h *ApplicationHandler) RescheduleInterview(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+	interviewID, err := strconv.Atoi(c.Param("interviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Interview ID"})
+		return
+	}
+
+	var req RescheduleInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	interview, err := h.appUseCase.RescheduleInterview(subject, dto.RescheduleInterviewInputDTO{
+		ApplicationID:  uint(appID),
+		InterviewID:    uint(interviewID),
+		StartsAt:       req.StartsAt,
+		EndsAt:         req.EndsAt,
+		InterviewerIDs: req.InterviewerIDs,
+		Location:       req.Location,
+		VideoURL:       req.VideoURL,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, interview)
+}
+
+// CancelInterview godoc
+// @Summary Cancel an interview
+// @Description Cancel a scheduled interview (members of the job's organization only). Attendees receive a cancellation of the calendar event.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param interviewId path int true "Interview ID"
+// @Param request body CancelInterviewRequest false "Cancel Interview Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.InterviewOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/interviews/{interviewId}/cancel [post]
+func (h *ApplicationHandler) CancelInterview(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+	interviewID, err := strconv.Atoi(c.Param("interviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Interview ID"})
+		return
+	}
+
+	var req CancelInterviewRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	interview, err := h.appUseCase.CancelInterview(subject, dto.CancelInterviewInputDTO{
+		ApplicationID: uint(appID),
+		InterviewID:   uint(interviewID),
+		Reason:        req.Reason,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, interview)
+}
+
+// RecordInterviewOutcome godoc
+// @Summary Record the outcome of an interview
+// @Description Mark an interview that has started as DONE or NO_SHOW (members of the job's organization only)
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param interviewId path int true "Interview ID"
+// @Param request body InterviewOutcomeRequest true "Interview Outcome Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.InterviewOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/interviews/{interviewId}/outcome [post]
+func (h *ApplicationHandler) RecordInterviewOutcome(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+	interviewID, err := strconv.Atoi(c.Param("interviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Interview ID"})
+		return
+	}
+
+	var req InterviewOutcomeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	interview, err := h.appUseCase.RecordInterviewOutcome(subject, dto.InterviewOutcomeInputDTO{
+		ApplicationID: uint(appID),
+		InterviewID:   uint(interviewID),
+		Status:        req.Status,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, interview)
+}
+
+// InterviewCalendar godoc
+// @Summary Download an interview as iCalendar
+// @Description Download the current state of an interview as an .ics file (its candidate or members of the job's organization)
+// @Tags applications
+// @Produce text/calendar
+// @Param id path int true "Application ID"
+// @Param interviewId path int true "Interview ID"
+// @Security BearerAuth
+// @Success 200 {file} file
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/interviews/{interviewId}/calendar [get]
+func (h *ApplicationHandler) InterviewCalendar(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+	interviewID, err := strconv.Atoi(c.Param("interviewId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Interview ID"})
+		return
+	}
+
+	ics, err := h.appUseCase.InterviewCalendar(subject, uint(appID), uint(interviewID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="interview.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", ics)
+}
+
+// GetJobInterviewSlots godoc
+// @Summary List the interview slots of a job
+// @Description List the upcoming interview slots of a job, booked or not (members of the job's organization only)
+// @Tags applications
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.InterviewSlotOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /jobs/{id}/interview-slots [get]
+func (h *ApplicationHandler) GetJobInterviewSlots(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	slots, err := h.appUseCase.GetJobInterviewSlots(subject, uint(jobID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, slots)
+}
+
+// PublishInterviewSlots godoc
+// @Summary Publish interview availability
+// @Description Split an availability window into consecutive slots of duration_minutes that candidates can book (members of the job's organization only). Without interviewer_id the caller is the interviewer; with stage_id only applications in that stage may book.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body PublishInterviewSlotsRequest true "Publish Interview Slots Request"
+// @Security BearerAuth
+// @Success 201 {array} dto.InterviewSlotOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/interview-slots [post]
+func (h *ApplicationHandler) PublishInterviewSlots(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	var req PublishInterviewSlotsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	slots, err := h.appUseCase.PublishInterviewSlots(subject, dto.PublishInterviewSlotsInputDTO{
+		JobID:           uint(jobID),
+		StartsAt:        req.StartsAt,
+		EndsAt:          req.EndsAt,
+		DurationMinutes: req.DurationMinutes,
+		InterviewerID:   req.InterviewerID,
+		StageID:         req.StageID,
+		Location:        req.Location,
+		VideoURL:        req.VideoURL,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, slots)
+}
+
+// DeleteInterviewSlot godoc
+// @Summary Withdraw an interview slot
+// @Description Remove a slot nobody has booked yet (members of the job's organization only)
+// @Tags applications
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param slotId path int true "Slot ID"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/interview-slots/{slotId} [delete]
+func (h *ApplicationHandler) DeleteInterviewSlot(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+	slotID, err := strconv.Atoi(c.Param("slotId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Slot ID"})
+		return
+	}
+
+	if err := h.appUseCase.DeleteInterviewSlot(subject, uint(jobID), uint(slotID)); err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Slot removed successfully"})
+}
+
+// GetOpenInterviewSlots godoc
+// @Summary List the interview slots I can book
+// @Description List the free slots the candidate of a pending application can book: at least an hour ahead and offered to the application's stage (the candidate only)
+// @Tags applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.InterviewSlotOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/interview-slots [get]
+func (h *ApplicationHandler) GetOpenInterviewSlots(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	slots, err := h.appUseCase.GetOpenInterviewSlots(subject, uint(appID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, slots)
+}
+
+// BookInterviewSlot godoc
+// @Summary Book an interview slot
+// @Description Book a free slot for my pending application (the candidate only). The interview is scheduled with the slot's interviewer and everyone receives an iCalendar invitation. A slot can only be booked once, and an application holds one booked interview at a time.
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param request body BookInterviewSlotRequest true "Book Interview Slot Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.InterviewOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/interview-slots [post]
+func (h *ApplicationHandler) BookInterviewSlot(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	var req BookInterviewSlotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	interview, err := h.appUseCase.BookInterviewSlot(subject, dto.BookInterviewSlotInputDTO{
+		ApplicationID: uint(appID),
+		SlotID:        req.SlotID,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, interview)
+}
+
+// formFile reads an optional uploaded file; a missing field yields nil.
+func formFile(c *gin.Context, field string) (*dto.FileInputDTO, error) {
+	header, err := c.FormFile(field)
+	if errors.Is(err, http.ErrMissingFile) || errors.Is(err, http.ErrNotMultipart) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.New("invalid upload: " + err.Error())
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.FileInputDTO{Filename: header.Filename, Data: data}, nil
+}
+
+type MoveApplicationRequest struct {
+	StageID uint   `json:"stage_id" binding:"required"`
+	Reason  string `json:"reason"`
+}
+
+type CreateNoteRequest struct {
+	ParentID   *uint  `json:"parent_id"`
+	Body       string `json:"body" binding:"required"`
+	Private    bool   `json:"private"`
+	MentionIDs []uint `json:"mention_ids"`
+}
+
+type UpdateNoteRequest struct {
+	Body       string `json:"body" binding:"required"`
+	MentionIDs []uint `json:"mention_ids"`
+}
+
+type SubmitScorecardRequest struct {
+	Ratings []dto.RatingInputDTO `json:"ratings" binding:"required"`
+	Comment string               `json:"comment"`
+}
+
+type ScheduleInterviewRequest struct {
+	StartsAt       time.Time `json:"starts_at" binding:"required"`
+	EndsAt         time.Time `json:"ends_at" binding:"required"`
+	InterviewerIDs []uint    `json:"interviewer_ids"`
+	Location       string    `json:"location"`
+	VideoURL       string    `json:"video_url"`
+}
+
+type RescheduleInterviewRequest struct {
+	StartsAt       time.Time `json:"starts_at" binding:"required"`
+	EndsAt         time.Time `json:"ends_at" binding:"required"`
+	InterviewerIDs *[]uint   `json:"interviewer_ids"`
+	Location       *string   `json:"location"`
+	VideoURL       *string   `json:"video_url"`
+}
+
+type CancelInterviewRequest struct {
+	Reason string `json:"reason"`
+}
+
+type InterviewOutcomeRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+type PublishInterviewSlotsRequest struct {
+	StartsAt        time.Time `json:"starts_at" binding:"required"`
+	EndsAt          time.Time `json:"ends_at" binding:"required"`
+	DurationMinutes int       `json:"duration_minutes" binding:"required"`
+	InterviewerID   uint      `json:"interviewer_id"`
+	StageID         *uint     `json:"stage_id"`
+	Location        string    `json:"location"`
+	VideoURL        string    `json:"video_url"`
+}
+
+type BookInterviewSlotRequest struct {
+	SlotID uint `json:"slot_id" binding:"required"`
+}
+
+type CancelApplicationRequest struct {
+	Reason string `json:"reason"`
 }
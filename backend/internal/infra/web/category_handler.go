@@ -0,0 +1,141 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/helberthlucas14/internal/dto"
+	"github.com/helberthlucas14/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryHandler struct {
+	categoryUseCase *usecase.CategoryUseCase
+}
+
+func NewCategoryHandler(categoryUseCase *usecase.CategoryUseCase) *CategoryHandler {
+	return &CategoryHandler{categoryUseCase: categoryUseCase}
+}
+
+// GetCategories godoc
+// @Summary List job categories
+// @Description List the managed job categories, sorted by name
+// @Tags categories
+// @Produce json
+// @Success 200 {array} dto.CategoryOutputDTO
+// @Router /categories [get]
+func (h *CategoryHandler) GetCategories(c *gin.Context) {
+	categories, err := h.categoryUseCase.ListCategories()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, categories)
+}
+
+// CreateCategory godoc
+// @Summary Create a job category (Admin only)
+// @Description Add a category jobs can be filed under. The slug defaults to the name without accents.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param request body CategoryRequest true "Category Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.CategoryOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /categories [post]
+func (h *CategoryHandler) CreateCategory(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	var req CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	category, err := h.categoryUseCase.CreateCategory(subject, dto.CategoryInputDTO{Name: req.Name, Slug: req.Slug})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, category)
+}
+
+// UpdateCategory godoc
+// @Summary Update a job category (Admin only)
+// @Description Rename a category or change its slug; omitted fields are kept
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param request body CategoryRequest true "Category Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.CategoryOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /categories/{id} [patch]
+func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Category ID"})
+		return
+	}
+
+	var req CategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	category, err := h.categoryUseCase.UpdateCategory(subject, uint(id), dto.CategoryInputDTO{Name: req.Name, Slug: req.Slug})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, category)
+}
+
+// DeleteCategory godoc
+// @Summary Delete a job category (Admin only)
+// @Description Delete a category no job is filed under
+// @Tags categories
+// @Produce json
+// @Param id path int true "Category ID"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Category ID"})
+		return
+	}
+
+	if err := h.categoryUseCase.DeleteCategory(subject, uint(id)); err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Category deleted successfully"})
+}
+
+type CategoryRequest struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
@@ -3,7 +3,6 @@ package web
 import (
 	"net/http"
 
-	"github.com/helberthlucas14/internal/domain"
 	"github.com/helberthlucas14/internal/usecase"
 
 	"github.com/gin-gonic/gin"
@@ -27,22 +26,15 @@ func NewDashboardHandler(appUseCase *usecase.ApplicationUseCase) *DashboardHandl
 // @Success 200 {object} dto.DashboardStatsDTO
 // @Router /dashboard/summary [get]
 func (h *DashboardHandler) GetSummary(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
+	subject, ok := currentSubject(c)
 	if !ok {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Invalid role"})
 		return
 	}
 
-	if role == domain.RoleCandidate {
-		candidateID := c.GetUint("user_id")
-		stats, err := h.appUseCase.GetCandidateStats(candidateID)
+	if subject.IsCandidate() {
+		stats, err := h.appUseCase.GetCandidateStats(subject)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+			c.JSON(errorStatus(err, http.StatusInternalServerError), ErrorResponse{Error: err.Error()})
 			return
 		}
 		c.JSON(http.StatusOK, stats)
@@ -0,0 +1,51 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/helberthlucas14/internal/infra/storage"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FileHandler serves files kept by the local storage backend through the
+// signed links it hands out. The S3 backend links to the bucket directly.
+type FileHandler struct {
+	files *storage.LocalStorage
+}
+
+func NewFileHandler(files *storage.LocalStorage) *FileHandler {
+	return &FileHandler{files: files}
+}
+
+// Download godoc
+// @Summary Download a stored file
+// @Description Serve a file through a signed, short-lived link such as the attachment URLs returned to recruiters
+// @Tags files
+// @Produce octet-stream
+// @Param key path string true "Storage key"
+// @Param name query string true "Download filename"
+// @Param expires query int true "Expiry (unix seconds)"
+// @Param signature query string true "Link signature"
+// @Success 200 {file} file
+// @Failure 403 {object} ErrorResponse
+// @Router /files/{key} [get]
+func (h *FileHandler) Download(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+
+	path, disposition, err := h.files.Open(key, c.Query("name"), c.Query("expires"), c.Query("signature"))
+	if errors.Is(err, storage.ErrInvalidSignature) {
+		c.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusNotFound, ErrorResponse{Error: "File not found"})
+		return
+	}
+
+	c.Header("Content-Disposition", disposition)
+	c.Header("Cache-Control", "private, no-store")
+	c.File(path)
+}
@@ -0,0 +1,200 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SubmitJob godoc
+// @Summary Submit a draft job for approval
+// @Description Ask the approvers of the job's organization to publish a complete DRAFT job, or to reopen an EXPIRED job with a future expires_at (members of the job's organization only). The job stays PENDING_APPROVAL until an owner or hiring manager decides. Only for organizations that require job approval.
+// @Tags job approvals
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body JobApprovalRequest false "Submit Job Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.JobApprovalOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/submit [post]
+func (h *JobHandler) SubmitJob(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	req, ok := bindJobApprovalRequest(c)
+	if !ok {
+		return
+	}
+
+	output, err := h.jobUseCase.SubmitJob(subject, uint(jobID), req.Comment)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, output)
+}
+
+// ApproveJob godoc
+// @Summary Approve a job
+// @Description Approve the pending approval request of a job (owners and hiring managers of the job's organization, except the requester). The job becomes OPEN, or SCHEDULED when its publish_at is still ahead.
+// @Tags job approvals
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body JobApprovalRequest false "Approve Job Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.JobApprovalOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/approve [post]
+func (h *JobHandler) ApproveJob(c *gin.Context) {
+	h.decideJob(c, true)
+}
+
+// RejectJob godoc
+// @Summary Reject a job
+// @Description Reject the pending approval request of a job with a comment (owners and hiring managers of the job's organization, except the requester). The job goes back to DRAFT.
+// @Tags job approvals
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body JobApprovalRequest true "Reject Job Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.JobApprovalOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/reject [post]
+func (h *JobHandler) RejectJob(c *gin.Context) {
+	h.decideJob(c, false)
+}
+
+func (h *JobHandler) decideJob(c *gin.Context, approve bool) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	req, ok := bindJobApprovalRequest(c)
+	if !ok {
+		return
+	}
+
+	output, err := h.jobUseCase.DecideJob(subject, dto.DecideJobInputDTO{
+		JobID:   uint(jobID),
+		Approve: approve,
+		Comment: req.Comment,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// GetJobApprovals godoc
+// @Summary List the approvals of a job
+// @Description List every approval request of a job and its decision, newest first (members of the job's organization only)
+// @Tags job approvals
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.JobApprovalOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/approvals [get]
+func (h *JobHandler) GetJobApprovals(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	output, err := h.jobUseCase.GetJobApprovals(subject, uint(jobID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// GetApprovalQueue godoc
+// @Summary List approval requests to decide
+// @Description List the approval requests of the organizations the caller approves jobs for (owners and hiring managers). Pending requests are listed by default; pass status=ALL for every request.
+// @Tags job approvals
+// @Produce json
+// @Param status query string false "Approval status (PENDING, APPROVED, REJECTED or ALL)" default(PENDING)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Security BearerAuth
+// @Success 200 {object} dto.PaginatedJobApprovalsOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Router /approvals [get]
+func (h *JobHandler) GetApprovalQueue(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	status := strings.ToUpper(strings.TrimSpace(c.DefaultQuery("status", string(domain.ApprovalPending))))
+	switch domain.ApprovalStatus(status) {
+	case domain.ApprovalPending, domain.ApprovalApproved, domain.ApprovalRejected:
+		// valid
+	default:
+		status = ""
+	}
+
+	output, err := h.jobUseCase.GetApprovalQueue(subject, dto.PaginationInputDTO{
+		Page:   page,
+		Limit:  limit,
+		Status: status,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// bindJobApprovalRequest reads the optional comment of an approval request,
+// answering 400 on malformed JSON.
+func bindJobApprovalRequest(c *gin.Context) (JobApprovalRequest, bool) {
+	var req JobApprovalRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return req, false
+		}
+	}
+	return req, true
+}
+
+type JobApprovalRequest struct {
+	Comment string `json:"comment" example:"Please add the salary range"`
+}
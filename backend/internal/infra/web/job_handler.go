@@ -1,16 +1,17 @@
 package web
 
 import (
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/helberthlucas14/internal/domain"
 	"github.com/helberthlucas14/internal/dto"
 
 	"github.com/helberthlucas14/internal/usecase"
 
-	"github.com/helberthlucas14/internal/domain"
-
 	"github.com/gin-gonic/gin"
 )
 
@@ -24,7 +25,7 @@ func NewJobHandler(jobUseCase *usecase.JobUseCase) *JobHandler {
 
 // CreateJob godoc
 // @Summary Create a new job
-// @Description Create a job posting (Recruiter only)
+// @Description Create a job posting owned by one of the recruiter's organizations (Recruiter only, verified email required). When organization_id is omitted the recruiter's only organization is used; a recruiter without one gets a new organization named after the company. With draft the job is saved as a DRAFT, hidden from candidates, and only the title is required; otherwise it is published right away and description and location are required too. A future publish_at creates the job SCHEDULED, hidden from candidates until then; after expires_at the job becomes EXPIRED. criteria names the scorecard criteria interviewers rate candidates on from 1 to 5; by default Technical, Communication and Culture.
 // @Tags jobs
 // @Accept json
 // @Produce json
@@ -33,16 +34,11 @@ func NewJobHandler(jobUseCase *usecase.JobUseCase) *JobHandler {
 // @Success 201 {object} dto.CreateJobOutputDTO
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Router /jobs [post]
 func (h *JobHandler) CreateJob(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleRecruiter {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only recruiters can create jobs"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
@@ -52,19 +48,28 @@ func (h *JobHandler) CreateJob(c *gin.Context) {
 		return
 	}
 
-	recruiterID := c.GetUint("user_id")
-	job, err := h.jobUseCase.CreateJob(dto.CreateJobInputDTO{
-		Title:        req.Title,
-		Description:  req.Description,
-		Company:      req.Company,
-		Location:     req.Location,
-		Requirements: req.Requirements,
-		Salary:       req.Salary,
-		RecruiterID:  recruiterID,
-		Anonymous:    req.Anonymous,
+	job, err := h.jobUseCase.CreateJob(subject, dto.CreateJobInputDTO{
+		Title:          req.Title,
+		Description:    req.Description,
+		Company:        req.Company,
+		Location:       req.Location,
+		Requirements:   req.Requirements,
+		CategoryID:     req.CategoryID,
+		EmploymentType: req.EmploymentType,
+		Seniority:      req.Seniority,
+		WorkModel:      req.WorkModel,
+		Salary:         req.Salary,
+		OrganizationID: req.OrganizationID,
+		Anonymous:      req.Anonymous,
+		PublishAt:      req.PublishAt,
+		ExpiresAt:      req.ExpiresAt,
+		Draft:          req.Draft,
+		Openings:       req.Openings,
+		Questions:      req.Questions,
+		Criteria:       req.Criteria,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -73,7 +78,7 @@ func (h *JobHandler) CreateJob(c *gin.Context) {
 
 // UpdateJob godoc
 // @Summary Update a job (Recruiter only)
-// @Description Update job fields; only OPEN or PAUSED jobs can be updated. Status can be OPEN or PAUSED.
+// @Description Update job fields; CLOSED jobs cannot be updated. Status follows DRAFT → OPEN ↔ PAUSED and can be set to OPEN or PAUSED: opening a DRAFT publishes it like POST /jobs/{id}/publish, opening a SCHEDULED job publishes it now, and an EXPIRED job reopens once expires_at is moved to the future. publish_at can only change while the job is a DRAFT or SCHEDULED. criteria replaces the scorecard criteria; criteria keeping their name keep their ratings.
 // @Tags jobs
 // @Accept json
 // @Produce json
@@ -83,16 +88,11 @@ func (h *JobHandler) CreateJob(c *gin.Context) {
 // @Success 200 {object} dto.GetJobOutputDTO
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Router /jobs/{id} [patch]
 func (h *JobHandler) UpdateJob(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleRecruiter {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only recruiters can update jobs"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
@@ -109,27 +109,264 @@ func (h *JobHandler) UpdateJob(c *gin.Context) {
 		return
 	}
 
-	recruiterID := c.GetUint("user_id")
-	output, err := h.jobUseCase.UpdateJob(recruiterID, uint(jobID), dto.UpdateJobInputDTO{
-		Title:        req.Title,
-		Description:  req.Description,
-		Company:      req.Company,
-		Location:     req.Location,
-		Requirements: req.Requirements,
-		Salary:       req.Salary,
-		Status:       req.Status,
+	output, err := h.jobUseCase.UpdateJob(subject, uint(jobID), dto.UpdateJobInputDTO{
+		Title:          req.Title,
+		Description:    req.Description,
+		Company:        req.Company,
+		Location:       req.Location,
+		Requirements:   req.Requirements,
+		CategoryID:     req.CategoryID,
+		EmploymentType: req.EmploymentType,
+		Seniority:      req.Seniority,
+		WorkModel:      req.WorkModel,
+		Salary:         req.Salary,
+		Status:         req.Status,
+		PublishAt:      req.PublishAt,
+		ExpiresAt:      req.ExpiresAt,
+		Openings:       req.Openings,
+		Questions:      req.Questions,
+		Criteria:       req.Criteria,
 	})
 	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, output)
+}
+
+// PublishJob godoc
+// @Summary Publish a draft job
+// @Description Publish a DRAFT job once its title, description, company and location are filled in (members of the job's organization only). The job becomes OPEN, or SCHEDULED when its publish_at is still ahead. Organizations that require job approval use the submit endpoint instead.
+// @Tags jobs
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {object} dto.GetJobOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/publish [post]
+func (h *JobHandler) PublishJob(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	output, err := h.jobUseCase.PublishJob(subject, uint(jobID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// HireCandidate godoc
+// @Summary Hire a candidate
+// @Description Hire one pending candidate without closing the job (members of the job's organization only). The hire that fills the job's last opening closes it, rejects the remaining candidates and withdraws their open offers.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body HireCandidateRequest true "Hire Candidate Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.HireCandidateOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/hire [post]
+func (h *JobHandler) HireCandidate(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	var req HireCandidateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 
+	output, err := h.jobUseCase.HireCandidate(subject, dto.HireCandidateInputDTO{
+		JobID:       uint(jobID),
+		CandidateID: req.CandidateID,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// ReopenJob godoc
+// @Summary Reopen a closed job
+// @Description Open a CLOSED job again (members of the job's organization only). Jobs of organizations that require approval go to PENDING_APPROVAL with a new approval request instead. The hired candidate stays hired. With restore_rejected, candidates rejected only because the job was closed go back to PENDING in their previous stage; candidates rejected by a recruiter stay rejected. A job past its expires_at needs a new one, and a job whose openings are all filled needs more openings before hiring again.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body ReopenJobRequest false "Reopen Job Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReopenJobOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/reopen [post]
+func (h *JobHandler) ReopenJob(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	var req ReopenJobRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	output, err := h.jobUseCase.ReopenJob(subject, dto.ReopenJobInputDTO{
+		JobID:           uint(jobID),
+		RestoreRejected: req.RestoreRejected,
+		ExpiresAt:       req.ExpiresAt,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// ArchiveJob godoc
+// @Summary Archive a job
+// @Description Hide a DRAFT, CLOSED or EXPIRED job from GET /jobs/mine and the public listing (members of the job's organization only). Archived jobs are listed with archived=true.
+// @Tags jobs
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {object} dto.GetJobOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/archive [post]
+func (h *JobHandler) ArchiveJob(c *gin.Context) {
+	h.archiveJob(c, true)
+}
+
+// UnarchiveJob godoc
+// @Summary Unarchive a job
+// @Description Bring an archived job back to GET /jobs/mine (members of the job's organization only)
+// @Tags jobs
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {object} dto.GetJobOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/unarchive [post]
+func (h *JobHandler) UnarchiveJob(c *gin.Context) {
+	h.archiveJob(c, false)
+}
+
+func (h *JobHandler) archiveJob(c *gin.Context, archived bool) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	output, err := h.jobUseCase.ArchiveJob(subject, uint(jobID), archived)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// DeleteJob godoc
+// @Summary Delete a job
+// @Description Soft-delete a job that is not OPEN or awaiting approval (members of the job's organization only). Candidates keep their applications; deleted jobs are listed with deleted=true and can be restored.
+// @Tags jobs
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id} [delete]
+func (h *JobHandler) DeleteJob(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	if err := h.jobUseCase.DeleteJob(subject, uint(jobID)); err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Job deleted successfully"})
+}
+
+// RestoreJob godoc
+// @Summary Restore a deleted job
+// @Description Undo the deletion of a job, keeping its status (members of the job's organization only)
+// @Tags jobs
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {object} dto.GetJobOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/restore [post]
+func (h *JobHandler) RestoreJob(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	output, err := h.jobUseCase.RestoreJob(subject, uint(jobID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
 	c.JSON(http.StatusOK, output)
 }
 
 // FinalizeJob godoc
 // @Summary Finalize a job and hire a candidate
-// @Description Close the job and mark the specified candidate as hired
+// @Description Close an OPEN or PAUSED job and mark the specified candidate as hired, whatever openings are left (members of the job's organization only). The remaining candidates are rejected and their open offers withdrawn. Use the hire endpoint to hire without closing the job.
 // @Tags jobs
 // @Accept json
 // @Produce json
@@ -138,16 +375,11 @@ func (h *JobHandler) UpdateJob(c *gin.Context) {
 // @Security BearerAuth
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Router /jobs/{id}/finalize [post]
 func (h *JobHandler) FinalizeJob(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleRecruiter {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only recruiters can finalize jobs"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
@@ -164,12 +396,12 @@ func (h *JobHandler) FinalizeJob(c *gin.Context) {
 		return
 	}
 
-	err = h.jobUseCase.FinalizeJob(dto.FinalizeJobInputDTO{
+	err = h.jobUseCase.FinalizeJob(subject, dto.FinalizeJobInputDTO{
 		JobID:       uint(jobID),
 		CandidateID: req.CandidateID,
 	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
 		return
 	}
 
@@ -178,36 +410,42 @@ func (h *JobHandler) FinalizeJob(c *gin.Context) {
 
 // GetJobs godoc
 // @Summary List all jobs
-// @Description Get all jobs with optional search query and pagination
+// @Description Get all jobs with optional search query, facet filters and pagination. Facet counts for each filter are returned next to meta, computed with every other filter applied. The query is matched in Portuguese and English against title, company, location, requirements and description; matching jobs are sorted by relevance and carry highlighted snippets. With lat and lng (or a near place name) jobs are sorted by distance, nearest first, and radius_km drops jobs farther away or without a known location.
 // @Tags jobs
 // @Accept json
 // @Produce json
-// @Param q query string false "Search query"
-// @Param status query string false "Status filter (OPEN|PAUSED|CLOSED)"
+// @Param q query string false "Search query (web search syntax: quoted phrases, or, -word)"
+// @Param status query string false "Status filter (OPEN|PAUSED|CLOSED|EXPIRED)"
+// @Param salary_min query int false "Only jobs paying at least this amount"
+// @Param salary_max query int false "Only jobs paying at most this amount"
+// @Param currency query string false "ISO 4217 salary currency (e.g. BRL); salary_min, salary_max and salary_band default it to BRL"
+// @Param salary_period query string false "Salary period (HOUR|MONTH|YEAR); salary_min, salary_max and salary_band default it to MONTH"
+// @Param salary_band query string false "Salary band (0-3000|3000-5000|5000-8000|8000-12000|12000+)"
+// @Param category query []string false "Category slug, repeatable" collectionFormat(multi)
+// @Param location query []string false "Location, repeatable" collectionFormat(multi)
+// @Param company query []string false "Company, repeatable" collectionFormat(multi)
+// @Param employment_type query []string false "Employment type (CLT|PJ|CONTRACT|INTERNSHIP), repeatable" collectionFormat(multi)
+// @Param seniority query []string false "Seniority (INTERN|JUNIOR|MID|SENIOR|LEAD), repeatable" collectionFormat(multi)
+// @Param work_model query []string false "Work model (REMOTE|HYBRID|ONSITE), repeatable" collectionFormat(multi)
+// @Param posted_within query string false "Posted within (24h|7d|30d)"
+// @Param lat query number false "Latitude to sort by distance from, with lng"
+// @Param lng query number false "Longitude to sort by distance from, with lat"
+// @Param near query string false "Brazilian city to sort by distance from, instead of lat and lng (e.g. Belo Horizonte - MG)"
+// @Param radius_km query number false "Only jobs within this distance in km"
 // @Param page query int false "Page number"
 // @Param limit query int false "Items per page"
 // @Success 200 {object} dto.PaginatedJobsOutputDTO
+// @Failure 400 {object} ErrorResponse
 // @Router /jobs [get]
 func (h *JobHandler) GetJobs(c *gin.Context) {
-	query := c.Query("q")
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	status := strings.ToUpper(strings.TrimSpace(c.Query("status")))
-	switch status {
-	case "OPEN", "PAUSED", "CLOSED":
-		// valid
-	default:
-		status = ""
+	input, ok := searchJobsInput(c)
+	if !ok {
+		return
 	}
 
-	jobs, err := h.jobUseCase.GetAllJobs(dto.PaginationInputDTO{
-		Page:   page,
-		Limit:  limit,
-		Query:  query,
-		Status: status,
-	})
+	jobs, err := h.jobUseCase.GetAllJobs(input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, jobs)
@@ -215,49 +453,40 @@ func (h *JobHandler) GetJobs(c *gin.Context) {
 
 // GetMyJobs godoc
 // @Summary List recruiter-owned jobs
-// @Description Get jobs created by the logged-in recruiter with optional search and pagination
+// @Description Get jobs owned by the logged-in recruiter's organizations with optional search, the same filters as GET /jobs and pagination
 // @Tags jobs
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param q query string false "Search query"
-// @Param status query string false "Status filter (OPEN|PAUSED|CLOSED)"
+// @Param status query string false "Status filter (DRAFT|PENDING_APPROVAL|SCHEDULED|OPEN|PAUSED|CLOSED|EXPIRED)"
+// @Param category query []string false "Category slug, repeatable" collectionFormat(multi)
+// @Param employment_type query []string false "Employment type (CLT|PJ|CONTRACT|INTERNSHIP), repeatable" collectionFormat(multi)
+// @Param seniority query []string false "Seniority (INTERN|JUNIOR|MID|SENIOR|LEAD), repeatable" collectionFormat(multi)
+// @Param work_model query []string false "Work model (REMOTE|HYBRID|ONSITE), repeatable" collectionFormat(multi)
+// @Param archived query bool false "List only archived jobs instead of hiding them"
+// @Param deleted query bool false "List only deleted jobs, which can be restored"
 // @Param page query int false "Page number"
 // @Param limit query int false "Items per page"
 // @Success 200 {object} dto.PaginatedJobsOutputDTO
+// @Failure 400 {object} ErrorResponse
 // @Router /jobs/mine [get]
 func (h *JobHandler) GetMyJobs(c *gin.Context) {
-	roleVal, exists := c.Get("role")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
-		return
-	}
-	role, ok := roleVal.(domain.Role)
-	if !ok || role != domain.RoleRecruiter {
-		c.JSON(http.StatusForbidden, ErrorResponse{Error: "Only recruiters can view their jobs"})
+	subject, ok := currentSubject(c)
+	if !ok {
 		return
 	}
 
-	query := c.Query("q")
-	status := strings.ToUpper(strings.TrimSpace(c.Query("status")))
-	switch status {
-	case "OPEN", "PAUSED", "CLOSED":
-	default:
-		status = ""
+	input, ok := searchJobsInput(c)
+	if !ok {
+		return
 	}
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-
-	recruiterID := c.GetUint("user_id")
+	input.Archived, _ = strconv.ParseBool(c.Query("archived"))
+	input.Deleted, _ = strconv.ParseBool(c.Query("deleted"))
 
-	jobs, err := h.jobUseCase.GetRecruiterJobs(recruiterID, dto.PaginationInputDTO{
-		Page:   page,
-		Limit:  limit,
-		Query:  query,
-		Status: status,
-	})
+	jobs, err := h.jobUseCase.GetRecruiterJobs(subject, input)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
 		return
 	}
 	c.JSON(http.StatusOK, jobs)
@@ -265,10 +494,11 @@ func (h *JobHandler) GetMyJobs(c *gin.Context) {
 
 // GetJob godoc
 // @Summary Get job by ID
-// @Description Get details of a specific job
+// @Description Get details of a specific job. Jobs not published yet are only returned to the recruiters managing them, identified by an optional bearer token.
 // @Tags jobs
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path int true "Job ID"
 // @Success 200 {object} dto.GetJobOutputDTO
 // @Failure 404 {object} ErrorResponse
@@ -281,7 +511,7 @@ func (h *JobHandler) GetJob(c *gin.Context) {
 		return
 	}
 
-	job, err := h.jobUseCase.GetJobByID(uint(id))
+	job, err := h.jobUseCase.GetJobByID(optionalSubject(c), uint(id))
 	if err != nil {
 		c.JSON(http.StatusNotFound, ErrorResponse{Error: "Job not found"})
 		return
@@ -291,25 +521,115 @@ func (h *JobHandler) GetJob(c *gin.Context) {
 }
 
 type CreateJobRequest struct {
-	Title        string `json:"title" binding:"required"`
-	Description  string `json:"description" binding:"required"`
-	Company      string `json:"company" binding:"required"`
-	Location     string `json:"location" binding:"required"`
-	Requirements string `json:"requirements"`
-	Salary       string `json:"salary"`
-	Anonymous    bool   `json:"anonymous"`
+	Title          string         `json:"title" binding:"required"`
+	Description    string         `json:"description"`
+	Company        string         `json:"company"`
+	Location       string         `json:"location"`
+	Requirements   string         `json:"requirements"`
+	CategoryID     *uint          `json:"category_id"`
+	EmploymentType string         `json:"employment_type" binding:"omitempty,oneof=CLT PJ CONTRACT INTERNSHIP"`
+	Seniority      string         `json:"seniority" binding:"omitempty,oneof=INTERN JUNIOR MID SENIOR LEAD"`
+	WorkModel      string         `json:"work_model" binding:"omitempty,oneof=REMOTE HYBRID ONSITE"`
+	Salary         *dto.SalaryDTO `json:"salary"`
+	OrganizationID uint           `json:"organization_id"`
+	Anonymous      bool           `json:"anonymous"`
+	PublishAt      *time.Time     `json:"publish_at" example:"2026-01-15T09:00:00-03:00"`
+	ExpiresAt      *time.Time     `json:"expires_at" example:"2026-02-15T23:59:59-03:00"`
+	Draft          bool           `json:"draft"`
+	Openings       int            `json:"openings" binding:"omitempty,min=1"`
+
+	Questions []dto.ScreeningQuestionInputDTO `json:"questions"`
+	Criteria  []string                        `json:"criteria" example:"Technical,Communication,Culture"`
 }
 
 type FinalizeJobRequest struct {
 	CandidateID uint `json:"candidate_id" binding:"required"`
 }
 
+type HireCandidateRequest struct {
+	CandidateID uint `json:"candidate_id" binding:"required"`
+}
+
+type ReopenJobRequest struct {
+	RestoreRejected bool       `json:"restore_rejected"`
+	ExpiresAt       *time.Time `json:"expires_at" example:"2026-02-15T23:59:59-03:00"`
+}
+
 type UpdateJobRequest struct {
-	Title        string `json:"title"`
-	Description  string `json:"description"`
-	Company      string `json:"company"`
-	Location     string `json:"location"`
-	Requirements string `json:"requirements"`
-	Salary       string `json:"salary"`
-	Status       string `json:"status"`
+	Title          string         `json:"title"`
+	Description    string         `json:"description"`
+	Company        string         `json:"company"`
+	Location       string         `json:"location"`
+	Requirements   string         `json:"requirements"`
+	CategoryID     *uint          `json:"category_id"`
+	EmploymentType string         `json:"employment_type" binding:"omitempty,oneof=CLT PJ CONTRACT INTERNSHIP"`
+	Seniority      string         `json:"seniority" binding:"omitempty,oneof=INTERN JUNIOR MID SENIOR LEAD"`
+	WorkModel      string         `json:"work_model" binding:"omitempty,oneof=REMOTE HYBRID ONSITE"`
+	Salary         *dto.SalaryDTO `json:"salary"`
+	Status         string         `json:"status"`
+	PublishAt      *time.Time     `json:"publish_at"`
+	ExpiresAt      *time.Time     `json:"expires_at"`
+	Openings       *int           `json:"openings" binding:"omitempty,min=1"`
+
+	Questions *[]dto.ScreeningQuestionInputDTO `json:"questions"`
+	Criteria  *[]string                        `json:"criteria"`
+}
+
+// searchJobsInput reads the search, filter and pagination parameters shared
+// by the job listings, answering 400 on malformed amounts.
+func searchJobsInput(c *gin.Context) (dto.SearchJobsInputDTO, bool) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	status := strings.ToUpper(strings.TrimSpace(c.Query("status")))
+	switch status {
+	case domain.JobStatusDraft, domain.JobStatusPendingApproval, domain.JobStatusScheduled, domain.JobStatusOpen, domain.JobStatusPaused, domain.JobStatusClosed, domain.JobStatusExpired:
+		// valid
+	default:
+		status = ""
+	}
+
+	input := dto.SearchJobsInputDTO{
+		PaginationInputDTO: dto.PaginationInputDTO{
+			Page:   page,
+			Limit:  limit,
+			Query:  c.Query("q"),
+			Status: status,
+		},
+		Currency:        strings.ToUpper(strings.TrimSpace(c.Query("currency"))),
+		SalaryPeriod:    strings.ToUpper(strings.TrimSpace(c.Query("salary_period"))),
+		SalaryBand:      c.Query("salary_band"),
+		Categories:      c.QueryArray("category"),
+		Locations:       c.QueryArray("location"),
+		Companies:       c.QueryArray("company"),
+		EmploymentTypes: c.QueryArray("employment_type"),
+		Seniorities:     c.QueryArray("seniority"),
+		WorkModels:      c.QueryArray("work_model"),
+		PostedWithin:    c.Query("posted_within"),
+		Near:            c.Query("near"),
+	}
+	for param, dst := range map[string]**int{"salary_min": &input.SalaryMin, "salary_max": &input.SalaryMax} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		amount, err := strconv.Atoi(raw)
+		if err != nil || amount < 0 {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid " + param})
+			return input, false
+		}
+		*dst = &amount
+	}
+	for param, dst := range map[string]**float64{"lat": &input.Lat, "lng": &input.Lng, "radius_km": &input.RadiusKm} {
+		raw := c.Query(param)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid " + param})
+			return input, false
+		}
+		*dst = &value
+	}
+	return input, true
 }
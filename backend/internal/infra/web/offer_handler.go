@@ -0,0 +1,307 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/helberthlucas14/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetOffers godoc
+// @Summary List the offers of an application
+// @Description List the offers made on an application, newest first (its candidate or members of the job's organization). Candidates do not see drafts.
+// @Tags offers
+// @Produce json
+// @Param id path int true "Application ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.OfferOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /applications/{id}/offers [get]
+func (h *JobHandler) GetOffers(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	output, err := h.jobUseCase.GetOffers(subject, uint(appID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// CreateOffer godoc
+// @Summary Draft an offer
+// @Description Draft an offer for a pending application (members of the job's organization who can move its candidates). Currency defaults to BRL and period to MONTH. Only one draft or sent offer may be open per application.
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param id path int true "Application ID"
+// @Param request body CreateOfferRequest true "Create Offer Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.OfferOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /applications/{id}/offers [post]
+func (h *JobHandler) CreateOffer(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	appID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Application ID"})
+		return
+	}
+
+	var req CreateOfferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "start_date must be a date like 2026-03-01"})
+		return
+	}
+
+	output, err := h.jobUseCase.CreateOffer(subject, dto.CreateOfferInputDTO{
+		ApplicationID: uint(appID),
+		Salary:        req.Salary,
+		Currency:      req.Currency,
+		Period:        req.Period,
+		StartDate:     startDate,
+		Benefits:      req.Benefits,
+		ExpiresAt:     req.ExpiresAt,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, output)
+}
+
+// UpdateOffer godoc
+// @Summary Update a draft offer
+// @Description Change the terms of a draft offer (members of the job's organization who can move its candidates). Omitted fields are kept. Sent offers cannot change.
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param id path int true "Offer ID"
+// @Param request body UpdateOfferRequest true "Update Offer Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.OfferOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /offers/{id} [patch]
+func (h *JobHandler) UpdateOffer(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	offerID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Offer ID"})
+		return
+	}
+
+	var req UpdateOfferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	input := dto.UpdateOfferInputDTO{
+		OfferID:   uint(offerID),
+		Salary:    req.Salary,
+		Currency:  req.Currency,
+		Period:    req.Period,
+		Benefits:  req.Benefits,
+		ExpiresAt: req.ExpiresAt,
+	}
+	if req.StartDate != nil {
+		startDate, err := time.Parse("2006-01-02", *req.StartDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "start_date must be a date like 2026-03-01"})
+			return
+		}
+		input.StartDate = &startDate
+	}
+
+	output, err := h.jobUseCase.UpdateOffer(subject, input)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// SendOffer godoc
+// @Summary Send an offer
+// @Description Send a draft offer to the candidate, who receives the offer letter by email (members of the job's organization who can move its candidates). The candidate can accept or decline it until it expires.
+// @Tags offers
+// @Produce json
+// @Param id path int true "Offer ID"
+// @Security BearerAuth
+// @Success 200 {object} dto.OfferOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /offers/{id}/send [post]
+func (h *JobHandler) SendOffer(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	offerID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Offer ID"})
+		return
+	}
+
+	output, err := h.jobUseCase.SendOffer(subject, uint(offerID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// AcceptOffer godoc
+// @Summary Accept an offer
+// @Description Accept a sent offer before it expires (its candidate only). The offer is accepted and the candidate hired together, and the job closes when its last opening fills, withdrawing the other open offers.
+// @Tags offers
+// @Produce json
+// @Param id path int true "Offer ID"
+// @Security BearerAuth
+// @Success 200 {object} dto.AcceptOfferOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /offers/{id}/accept [post]
+func (h *JobHandler) AcceptOffer(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	offerID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Offer ID"})
+		return
+	}
+
+	output, err := h.jobUseCase.AcceptOffer(subject, uint(offerID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// DeclineOffer godoc
+// @Summary Decline an offer
+// @Description Decline a sent offer before it expires, optionally with a reason (its candidate only). The application stays in the hiring process.
+// @Tags offers
+// @Accept json
+// @Produce json
+// @Param id path int true "Offer ID"
+// @Param request body DeclineOfferRequest false "Decline Offer Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.OfferOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /offers/{id}/decline [post]
+func (h *JobHandler) DeclineOffer(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	offerID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Offer ID"})
+		return
+	}
+
+	var req DeclineOfferRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+	}
+
+	output, err := h.jobUseCase.DeclineOffer(subject, uint(offerID), req.Reason)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, output)
+}
+
+// OfferLetter godoc
+// @Summary Download an offer letter
+// @Description Download the letter of an offer as a PDF, rendered from the organization's offer letter template or the default one (its candidate or members of the job's organization)
+// @Tags offers
+// @Produce application/pdf
+// @Param id path int true "Offer ID"
+// @Security BearerAuth
+// @Success 200 {file} file
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /offers/{id}/letter [get]
+func (h *JobHandler) OfferLetter(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	offerID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Offer ID"})
+		return
+	}
+
+	pdf, err := h.jobUseCase.OfferLetter(subject, uint(offerID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="offer-letter.pdf"`)
+	c.Data(http.StatusOK, "application/pdf", pdf)
+}
+
+type CreateOfferRequest struct {
+	Salary    int       `json:"salary" binding:"required" example:"8500"`
+	Currency  string    `json:"currency" example:"BRL"`
+	Period    string    `json:"period" example:"MONTH"`
+	StartDate string    `json:"start_date" binding:"required" example:"2026-03-01"`
+	Benefits  string    `json:"benefits" example:"Health insurance, meal allowance"`
+	ExpiresAt time.Time `json:"expires_at" binding:"required" example:"2026-02-10T23:59:00Z"`
+}
+
+type UpdateOfferRequest struct {
+	Salary    *int       `json:"salary" example:"9000"`
+	Currency  *string    `json:"currency" example:"BRL"`
+	Period    *string    `json:"period" example:"MONTH"`
+	StartDate *string    `json:"start_date" example:"2026-03-15"`
+	Benefits  *string    `json:"benefits" example:"Health insurance, meal allowance"`
+	ExpiresAt *time.Time `json:"expires_at" example:"2026-02-15T23:59:00Z"`
+}
+
+type DeclineOfferRequest struct {
+	Reason string `json:"reason" example:"I accepted another offer"`
+}
@@ -0,0 +1,405 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/helberthlucas14/internal/dto"
+	"github.com/helberthlucas14/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrganizationHandler struct {
+	orgUseCase *usecase.OrganizationUseCase
+}
+
+func NewOrganizationHandler(orgUseCase *usecase.OrganizationUseCase) *OrganizationHandler {
+	return &OrganizationHandler{orgUseCase: orgUseCase}
+}
+
+// CreateOrganization godoc
+// @Summary Create an organization
+// @Description Create a hiring organization; the caller becomes its owner (Recruiter only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param request body CreateOrganizationRequest true "Create Organization Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.OrganizationOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Router /organizations [post]
+func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	var req CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	org, err := h.orgUseCase.Create(subject, dto.CreateOrganizationInputDTO{
+		Name: req.Name,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, org)
+}
+
+// GetMyOrganizations godoc
+// @Summary List my organizations
+// @Description List organizations the logged-in recruiter belongs to
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.OrganizationOutputDTO
+// @Router /organizations/mine [get]
+func (h *OrganizationHandler) GetMyOrganizations(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgs, err := h.orgUseCase.GetMyOrganizations(subject)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, orgs)
+}
+
+// GetMembers godoc
+// @Summary List organization members
+// @Description List the recruiters of an organization (members only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.OrganizationMemberOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Router /organizations/{id}/members [get]
+func (h *OrganizationHandler) GetMembers(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+
+	members, err := h.orgUseCase.GetMembers(subject, uint(orgID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, members)
+}
+
+// UpdateOrganization godoc
+// @Summary Update an organization
+// @Description Rename the organization, require its jobs to be approved before they go live, or set the text/template its offer letters are generated from (owners only). Templates can use {{.Date}}, {{.CandidateName}}, {{.JobTitle}}, {{.Company}}, {{.Salary}}, {{.StartDate}}, {{.Benefits}}, {{.ExpiresAt}} and {{.SenderName}}; an empty template restores the default one.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param request body UpdateOrganizationRequest true "Update Organization Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.OrganizationOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /organizations/{id} [patch]
+func (h *OrganizationHandler) UpdateOrganization(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+
+	var req UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	org, err := h.orgUseCase.Update(subject, uint(orgID), dto.UpdateOrganizationInputDTO{
+		Name:                req.Name,
+		RequireJobApproval:  req.RequireJobApproval,
+		OfferLetterTemplate: req.OfferLetterTemplate,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, org)
+}
+
+// UpdateMember godoc
+// @Summary Change a member's role
+// @Description Make a member a RECRUITER, a HIRING_MANAGER, who also approves jobs, or an OWNER (owners only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param userId path int true "User ID"
+// @Param request body UpdateMemberRequest true "Update Member Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.OrganizationMemberOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /organizations/{id}/members/{userId} [patch]
+func (h *OrganizationHandler) UpdateMember(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid User ID"})
+		return
+	}
+
+	var req UpdateMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	member, err := h.orgUseCase.UpdateMemberRole(subject, dto.UpdateMemberInputDTO{
+		OrganizationID: uint(orgID),
+		UserID:         uint(userID),
+		Role:           req.Role,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, member)
+}
+
+// RemoveMember godoc
+// @Summary Remove an organization member
+// @Description Remove a recruiter from the organization (owners only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param userId path int true "User ID"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ErrorResponse
+// @Router /organizations/{id}/members/{userId} [delete]
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+	userID, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid User ID"})
+		return
+	}
+
+	if err := h.orgUseCase.RemoveMember(subject, uint(orgID), uint(userID)); err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Member removed successfully"})
+}
+
+// InviteMember godoc
+// @Summary Invite a recruiter
+// @Description Email an invitation to join the organization (owners only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param request body InviteMemberRequest true "Invite Member Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.InvitationOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Router /organizations/{id}/invitations [post]
+func (h *OrganizationHandler) InviteMember(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+
+	var req InviteMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	invitation, err := h.orgUseCase.Invite(subject, dto.InviteMemberInputDTO{
+		OrganizationID: uint(orgID),
+		Email:          req.Email,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// GetInvitations godoc
+// @Summary List pending invitations
+// @Description List pending invitations of an organization (members only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.InvitationOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Router /organizations/{id}/invitations [get]
+func (h *OrganizationHandler) GetInvitations(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+
+	invitations, err := h.orgUseCase.GetPendingInvitations(subject, uint(orgID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+// RevokeInvitation godoc
+// @Summary Revoke an invitation
+// @Description Revoke a pending invitation (owners only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param invitationId path int true "Invitation ID"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} ErrorResponse
+// @Router /organizations/{id}/invitations/{invitationId} [delete]
+func (h *OrganizationHandler) RevokeInvitation(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+	invitationID, err := strconv.Atoi(c.Param("invitationId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Invitation ID"})
+		return
+	}
+
+	if err := h.orgUseCase.RevokeInvitation(subject, uint(orgID), uint(invitationID)); err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Invitation revoked successfully"})
+}
+
+// AcceptInvitation godoc
+// @Summary Accept an invitation
+// @Description Join an organization using the token received by email (Recruiter only)
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param request body AcceptInvitationRequest true "Accept Invitation Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.OrganizationOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Router /invitations/accept [post]
+func (h *OrganizationHandler) AcceptInvitation(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	var req AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	org, err := h.orgUseCase.AcceptInvitation(subject, req.Token)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, org)
+}
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+type UpdateOrganizationRequest struct {
+	Name                string  `json:"name"`
+	RequireJobApproval  *bool   `json:"require_job_approval"`
+	OfferLetterTemplate *string `json:"offer_letter_template"`
+}
+
+type UpdateMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=OWNER RECRUITER HIRING_MANAGER"`
+}
+
+type InviteMemberRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token" binding:"required"`
+}
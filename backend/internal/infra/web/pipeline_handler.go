@@ -0,0 +1,171 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/helberthlucas14/internal/dto"
+	"github.com/helberthlucas14/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PipelineHandler struct {
+	pipelineUseCase *usecase.PipelineUseCase
+}
+
+func NewPipelineHandler(pipelineUseCase *usecase.PipelineUseCase) *PipelineHandler {
+	return &PipelineHandler{pipelineUseCase: pipelineUseCase}
+}
+
+// GetJobPipeline godoc
+// @Summary Get a job's pipeline
+// @Description List the ordered stages applications of this job move through (members of the job's organization only)
+// @Tags pipelines
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.PipelineStageOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/pipeline [get]
+func (h *PipelineHandler) GetJobPipeline(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	stages, err := h.pipelineUseCase.GetJobPipeline(subject, uint(jobID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, stages)
+}
+
+// UpdateJobPipeline godoc
+// @Summary Customize a job's pipeline
+// @Description Replace the job's active stages with the given ordered names. Hired and Rejected stages are always appended. Stages holding applications cannot be removed.
+// @Tags pipelines
+// @Accept json
+// @Produce json
+// @Param id path int true "Job ID"
+// @Param request body UpdatePipelineRequest true "Update Pipeline Request"
+// @Security BearerAuth
+// @Success 200 {array} dto.PipelineStageOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /jobs/{id}/pipeline [put]
+func (h *PipelineHandler) UpdateJobPipeline(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	jobID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Job ID"})
+		return
+	}
+
+	var req UpdatePipelineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	stages, err := h.pipelineUseCase.UpdateJobPipeline(subject, uint(jobID), dto.UpdatePipelineInputDTO{
+		Stages: req.Stages,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, stages)
+}
+
+// GetOrganizationPipeline godoc
+// @Summary Get an organization's default pipeline
+// @Description List the default stages used by jobs of this organization without their own pipeline (members only)
+// @Tags pipelines
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Security BearerAuth
+// @Success 200 {array} dto.PipelineStageOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Router /organizations/{id}/pipeline [get]
+func (h *PipelineHandler) GetOrganizationPipeline(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+
+	stages, err := h.pipelineUseCase.GetOrganizationPipeline(subject, uint(orgID))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, stages)
+}
+
+// UpdateOrganizationPipeline godoc
+// @Summary Customize an organization's default pipeline
+// @Description Replace the organization's default active stages with the given ordered names (owners only)
+// @Tags pipelines
+// @Accept json
+// @Produce json
+// @Param id path int true "Organization ID"
+// @Param request body UpdatePipelineRequest true "Update Pipeline Request"
+// @Security BearerAuth
+// @Success 200 {array} dto.PipelineStageOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /organizations/{id}/pipeline [put]
+func (h *PipelineHandler) UpdateOrganizationPipeline(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid Organization ID"})
+		return
+	}
+
+	var req UpdatePipelineRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	stages, err := h.pipelineUseCase.UpdateOrganizationPipeline(subject, uint(orgID), dto.UpdatePipelineInputDTO{
+		Stages: req.Stages,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, stages)
+}
+
+type UpdatePipelineRequest struct {
+	Stages []string `json:"stages" binding:"required,min=1"`
+}
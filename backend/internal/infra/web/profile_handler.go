@@ -0,0 +1,157 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/helberthlucas14/internal/dto"
+	"github.com/helberthlucas14/internal/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProfileHandler struct {
+	profileUseCase *usecase.ProfileUseCase
+}
+
+func NewProfileHandler(profileUseCase *usecase.ProfileUseCase) *ProfileHandler {
+	return &ProfileHandler{profileUseCase: profileUseCase}
+}
+
+// GetMyProfile godoc
+// @Summary Get my candidate profile
+// @Description Return the authenticated candidate's profile
+// @Tags profile
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.CandidateProfileOutputDTO
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /me/profile [get]
+func (h *ProfileHandler) GetMyProfile(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.profileUseCase.GetMyProfile(subject)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// CreateMyProfile godoc
+// @Summary Create my candidate profile
+// @Description Create the authenticated candidate's profile. Dates use the YYYY-MM format; an empty end date means the entry is ongoing.
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Param request body ProfileRequest true "Profile Request"
+// @Security BearerAuth
+// @Success 201 {object} dto.CandidateProfileOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /me/profile [post]
+func (h *ProfileHandler) CreateMyProfile(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	var req ProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	profile, err := h.profileUseCase.CreateMyProfile(subject, req.toInput())
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, profile)
+}
+
+// UpdateMyProfile godoc
+// @Summary Replace my candidate profile
+// @Description Replace the authenticated candidate's profile; lists left out of the request are cleared
+// @Tags profile
+// @Accept json
+// @Produce json
+// @Param request body ProfileRequest true "Profile Request"
+// @Security BearerAuth
+// @Success 200 {object} dto.CandidateProfileOutputDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Router /me/profile [put]
+func (h *ProfileHandler) UpdateMyProfile(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	var req ProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	profile, err := h.profileUseCase.UpdateMyProfile(subject, req.toInput())
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// DeleteMyProfile godoc
+// @Summary Delete my candidate profile
+// @Description Delete the authenticated candidate's profile
+// @Tags profile
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Router /me/profile [delete]
+func (h *ProfileHandler) DeleteMyProfile(c *gin.Context) {
+	subject, ok := currentSubject(c)
+	if !ok {
+		return
+	}
+
+	if err := h.profileUseCase.DeleteMyProfile(subject); err != nil {
+		c.JSON(errorStatus(err, http.StatusNotFound), ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Profile deleted successfully"})
+}
+
+type ProfileRequest struct {
+	Headline        string                  `json:"headline" binding:"max=120"`
+	Location        string                  `json:"location"`
+	DesiredSalary   *int                    `json:"desired_salary"`
+	DesiredCurrency string                  `json:"desired_currency"`
+	Skills          []string                `json:"skills"`
+	Links           []dto.ProfileLinkDTO    `json:"links"`
+	Experiences     []dto.WorkExperienceDTO `json:"experiences"`
+	Education       []dto.EducationDTO      `json:"education"`
+}
+
+func (r ProfileRequest) toInput() dto.CandidateProfileInputDTO {
+	return dto.CandidateProfileInputDTO{
+		Headline:        r.Headline,
+		Location:        r.Location,
+		DesiredSalary:   r.DesiredSalary,
+		DesiredCurrency: r.DesiredCurrency,
+		Skills:          r.Skills,
+		Links:           r.Links,
+		Experiences:     r.Experiences,
+		Education:       r.Education,
+	}
+}
@@ -0,0 +1,41 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// currentSubject builds the authorization subject from the claims set by
+// AuthMiddleware, answering 401 when they are missing.
+func currentSubject(c *gin.Context) (authz.Subject, bool) {
+	roleVal, exists := c.Get("role")
+	role, ok := roleVal.(domain.Role)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{Error: "Unauthorized"})
+		return authz.Subject{}, false
+	}
+	return authz.Subject{UserID: c.GetUint("user_id"), Role: role}, true
+}
+
+// optionalSubject is currentSubject for routes open to anonymous visitors. It
+// returns the zero Subject, which is allowed nothing, when nobody is logged in.
+func optionalSubject(c *gin.Context) authz.Subject {
+	role, ok := c.Value("role").(domain.Role)
+	if !ok {
+		return authz.Subject{}
+	}
+	return authz.Subject{UserID: c.GetUint("user_id"), Role: role}
+}
+
+// errorStatus maps policy denials to 403 and everything else to fallback.
+func errorStatus(err error, fallback int) int {
+	if errors.Is(err, authz.ErrForbidden) {
+		return http.StatusForbidden
+	}
+	return fallback
+}
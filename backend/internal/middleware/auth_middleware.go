@@ -11,42 +11,72 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
+func AuthMiddleware(jwtSecret string, tokenRepo domain.RefreshTokenRepository) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is required"})
+		if msg := authenticate(c, jwtSecret, tokenRepo); msg != "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": msg})
 			return
 		}
 
-		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-		if tokenString == authHeader {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Bearer token required"})
-			return
+		c.Next()
+	}
+}
+
+// OptionalAuthMiddleware identifies the user like AuthMiddleware when a valid
+// token is sent, and otherwise lets the request through anonymously.
+func OptionalAuthMiddleware(jwtSecret string, tokenRepo domain.RefreshTokenRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "" {
+			authenticate(c, jwtSecret, tokenRepo)
 		}
 
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(jwtSecret), nil
-		})
+		c.Next()
+	}
+}
 
-		if err != nil || !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
-			return
-		}
+// authenticate checks the bearer token and stores the user in the context. It
+// returns the reason the request is unauthorized, or "" on success.
+func authenticate(c *gin.Context, jwtSecret string, tokenRepo domain.RefreshTokenRepository) string {
+	authHeader := c.GetHeader("Authorization")
+	if authHeader == "" {
+		return "Authorization header is required"
+	}
 
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			userID := uint(claims["user_id"].(float64))
-			role := domain.Role(claims["role"].(string))
-			c.Set("user_id", userID)
-			c.Set("role", role)
-		} else {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token claims"})
-			return
+	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+	if tokenString == authHeader {
+		return "Bearer token required"
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
+		return []byte(jwtSecret), nil
+	})
 
-		c.Next()
+	if err != nil || !token.Valid {
+		return "Invalid token"
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "Invalid token claims"
 	}
+
+	userIDVal, okUser := claims["user_id"].(float64)
+	roleVal, okRole := claims["role"].(string)
+	sessionID, okSession := claims["sid"].(string)
+	if !okUser || !okRole || !okSession || sessionID == "" {
+		return "Invalid token claims"
+	}
+
+	active, err := tokenRepo.IsSessionActive(sessionID)
+	if err != nil || !active {
+		return "Session has been revoked"
+	}
+
+	c.Set("user_id", uint(userIDVal))
+	c.Set("role", domain.Role(roleVal))
+	c.Set("session_id", sessionID)
+	return ""
 }
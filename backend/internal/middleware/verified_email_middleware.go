@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/helberthlucas14/internal/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RequireVerifiedEmail(userRepo domain.UserRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := userRepo.FindByID(c.GetUint("user_id"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
+			return
+		}
+
+		if !user.IsEmailVerified() {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Email address must be verified"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const maxNoteLength = 5000
+
+// GetNotes returns the note threads of an application the subject may read,
+// oldest first.
+func (uc *ApplicationUseCase) GetNotes(subject authz.Subject, appID uint) ([]dto.ApplicationNoteOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(appID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationNotes, app); err != nil {
+		return nil, err
+	}
+
+	notes, err := uc.noteRepo.FindByApplicationIDs([]uint{app.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	threads := noteThreads(notes, subject.UserID)[app.ID]
+	if threads == nil {
+		threads = []dto.ApplicationNoteOutputDTO{}
+	}
+	return threads, nil
+}
+
+// AddNote starts a thread on an application or replies to one. Replies join
+// the thread of the note they answer and share its visibility. Mentioned
+// recruiters must be on the job's hiring team and are notified by email.
+func (uc *ApplicationUseCase) AddNote(subject authz.Subject, input dto.CreateNoteInputDTO) (*dto.ApplicationNoteOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(input.ApplicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationNotes, app); err != nil {
+		return nil, err
+	}
+
+	body, err := noteBody(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &domain.ApplicationNote{
+		ApplicationID: app.ID,
+		AuthorID:      subject.UserID,
+		Body:          body,
+		Private:       input.Private,
+	}
+
+	if input.ParentID != nil {
+		root, err := uc.threadOf(app.ID, *input.ParentID, subject.UserID)
+		if err != nil {
+			return nil, err
+		}
+		note.ParentID = &root.ID
+		note.Private = root.Private
+	}
+
+	team, err := uc.hiringTeam(&app.Job)
+	if err != nil {
+		return nil, err
+	}
+	note.Mentions, err = mentionsOf(team, subject.UserID, input.MentionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.noteRepo.Create(note); err != nil {
+		return nil, err
+	}
+
+	saved, err := uc.noteRepo.FindByID(note.ID)
+	if err != nil {
+		return nil, err
+	}
+	uc.notifyMentions(app, saved, saved.Mentions)
+
+	output := toNoteOutput(saved)
+	return &output, nil
+}
+
+// UpdateNote changes the text and mentions of a note. Only its author may
+// edit it, and the previous text is kept as a revision. Recruiters who were
+// not mentioned before are notified.
+func (uc *ApplicationUseCase) UpdateNote(subject authz.Subject, input dto.UpdateNoteInputDTO) (*dto.ApplicationNoteOutputDTO, error) {
+	note, err := uc.noteRepo.FindByID(input.NoteID)
+	if err != nil || note.ApplicationID != input.ApplicationID {
+		return nil, errors.New("note not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationNoteEdit, note); err != nil {
+		return nil, err
+	}
+
+	body, err := noteBody(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := uc.hiringTeam(&note.Application.Job)
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := mentionsOf(team, subject.UserID, input.MentionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var added []domain.NoteMention
+	for _, m := range mentions {
+		if !note.Mentioned(m.UserID) {
+			added = append(added, m)
+		}
+	}
+	if body == note.Body && len(added) == 0 && len(mentions) == len(note.Mentions) {
+		return nil, errors.New("note is unchanged")
+	}
+
+	revision := &domain.NoteRevision{
+		Body:       note.Body,
+		EditedByID: subject.UserID,
+	}
+	now := time.Now()
+	note.Body = body
+	note.Mentions = mentions
+	note.EditedAt = &now
+	if err := uc.noteRepo.UpdateWithRevision(note, revision); err != nil {
+		return nil, err
+	}
+
+	saved, err := uc.noteRepo.FindByID(note.ID)
+	if err != nil {
+		return nil, err
+	}
+	uc.notifyMentions(&note.Application, saved, added)
+
+	output := toNoteOutput(saved)
+	return &output, nil
+}
+
+// threadOf finds the first note of the thread noteID belongs to, as long as
+// userID may read that thread.
+func (uc *ApplicationUseCase) threadOf(appID, noteID, userID uint) (*dto.ApplicationNoteOutputDTO, error) {
+	notes, err := uc.noteRepo.FindByApplicationIDs([]uint{appID})
+	if err != nil {
+		return nil, err
+	}
+
+	rootID := noteID
+	for _, n := range notes {
+		if n.ID == noteID && n.ParentID != nil {
+			rootID = *n.ParentID
+		}
+	}
+
+	threads := noteThreads(notes, userID)[appID]
+	for i := range threads {
+		if threads[i].ID == rootID {
+			return &threads[i], nil
+		}
+	}
+	return nil, errors.New("note not found")
+}
+
+// hiringTeam returns the recruiters who can be mentioned on applications for
+// job, by user ID. Jobs without an organization have no one to mention.
+func (uc *ApplicationUseCase) hiringTeam(job *domain.Job) (map[uint]domain.User, error) {
+	team := make(map[uint]domain.User)
+	if job.OrganizationID == nil {
+		return team, nil
+	}
+
+	members, err := uc.orgRepo.FindMembers(*job.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range members {
+		team[m.UserID] = m.User
+	}
+	return team, nil
+}
+
+func (uc *ApplicationUseCase) notifyMentions(app *domain.Application, note *domain.ApplicationNote, mentions []domain.NoteMention) {
+	for _, m := range mentions {
+		err := uc.mailer.Send(domain.MailMessage{
+			To:      []string{m.User.Email},
+			Subject: fmt.Sprintf("%s mentioned you on an application for %s", note.Author.Name, app.Job.Title),
+			Body: fmt.Sprintf("Hello %s,\n\n%s mentioned you in a note on an application for %s:\n\n%s\n\nOpen the job to reply:\n\n%s/jobs/%d/manage\n",
+				m.User.Name, note.Author.Name, app.Job.Title, note.Body, uc.appURL, app.JobID),
+		})
+		if err != nil {
+			log.Println("Application: failed to send mention email:", err)
+		}
+	}
+}
+
+func noteBody(raw string) (string, error) {
+	body := strings.TrimSpace(raw)
+	if body == "" {
+		return "", errors.New("note body is required")
+	}
+	if utf8.RuneCountInString(body) > maxNoteLength {
+		return "", fmt.Errorf("note body must be at most %d characters", maxNoteLength)
+	}
+	return body, nil
+}
+
+// mentionsOf checks that every mentioned user is on the hiring team. Authors
+// mentioning themselves and repeated IDs are ignored.
+func mentionsOf(team map[uint]domain.User, authorID uint, userIDs []uint) ([]domain.NoteMention, error) {
+	var mentions []domain.NoteMention
+	seen := make(map[uint]bool, len(userIDs))
+	for _, id := range userIDs {
+		if id == authorID || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		user, ok := team[id]
+		if !ok {
+			return nil, fmt.Errorf("user %d is not on the hiring team of this job", id)
+		}
+		mentions = append(mentions, domain.NoteMention{UserID: id, User: user})
+	}
+	return mentions, nil
+}
+
+// noteThreads groups notes, oldest first, into threads by application and
+// drops the private threads userID neither wrote in nor was mentioned in.
+func noteThreads(notes []domain.ApplicationNote, userID uint) map[uint][]dto.ApplicationNoteOutputDTO {
+	replies := make(map[uint][]dto.ApplicationNoteOutputDTO)
+	involved := make(map[uint]bool)
+	for i := range notes {
+		n := &notes[i]
+		if n.AuthorID == userID || n.Mentioned(userID) {
+			involved[n.ID] = true
+		}
+		if n.ParentID == nil {
+			continue
+		}
+		replies[*n.ParentID] = append(replies[*n.ParentID], toNoteOutput(n))
+		if involved[n.ID] {
+			involved[*n.ParentID] = true
+		}
+	}
+
+	threads := make(map[uint][]dto.ApplicationNoteOutputDTO)
+	for i := range notes {
+		n := &notes[i]
+		if n.ParentID != nil || (n.Private && !involved[n.ID]) {
+			continue
+		}
+		output := toNoteOutput(n)
+		output.Replies = replies[n.ID]
+		threads[n.ApplicationID] = append(threads[n.ApplicationID], output)
+	}
+	return threads
+}
+
+func toNoteOutput(n *domain.ApplicationNote) dto.ApplicationNoteOutputDTO {
+	output := dto.ApplicationNoteOutputDTO{
+		ID:            n.ID,
+		ApplicationID: n.ApplicationID,
+		ParentID:      n.ParentID,
+		AuthorID:      n.AuthorID,
+		AuthorName:    n.Author.Name,
+		Body:          n.Body,
+		Private:       n.Private,
+		Mentions:      make([]dto.NoteMentionOutputDTO, len(n.Mentions)),
+		CreatedAt:     n.CreatedAt.Format(time.RFC3339),
+	}
+	for i, m := range n.Mentions {
+		output.Mentions[i] = dto.NoteMentionOutputDTO{UserID: m.UserID, Name: m.User.Name}
+	}
+	for _, r := range n.Revisions {
+		output.Revisions = append(output.Revisions, dto.NoteRevisionOutputDTO{
+			Body:     r.Body,
+			EditedAt: r.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	if n.EditedAt != nil {
+		output.EditedAt = n.EditedAt.Format(time.RFC3339)
+	}
+	return output
+}
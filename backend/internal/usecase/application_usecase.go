@@ -2,31 +2,66 @@ package usecase
 
 import (
 	"errors"
+	"log"
+	"strings"
+	"time"
 
+	"github.com/helberthlucas14/internal/authz"
 	"github.com/helberthlucas14/internal/domain"
 	"github.com/helberthlucas14/internal/dto"
 )
 
+const attachmentURLTTL = 15 * time.Minute
+
 type ApplicationUseCase struct {
-	appRepo domain.ApplicationRepository
-	jobRepo domain.JobRepository
+	appRepo       domain.ApplicationRepository
+	jobRepo       domain.JobRepository
+	profileRepo   domain.CandidateProfileRepository
+	noteRepo      domain.ApplicationNoteRepository
+	scorecardRepo domain.ScorecardRepository
+	interviewRepo domain.InterviewRepository
+	slotRepo      domain.InterviewSlotRepository
+	orgRepo       domain.OrganizationRepository
+	pipelines     pipelines
+	storage       domain.FileStorage
+	mailer        domain.MailSender
+	policy        *authz.Policy
+	appURL        string
 }
 
-func NewApplicationUseCase(appRepo domain.ApplicationRepository, jobRepo domain.JobRepository) *ApplicationUseCase {
-	return &ApplicationUseCase{appRepo: appRepo, jobRepo: jobRepo}
+func NewApplicationUseCase(appRepo domain.ApplicationRepository, jobRepo domain.JobRepository, pipelineRepo domain.PipelineRepository, profileRepo domain.CandidateProfileRepository, noteRepo domain.ApplicationNoteRepository, scorecardRepo domain.ScorecardRepository, interviewRepo domain.InterviewRepository, slotRepo domain.InterviewSlotRepository, orgRepo domain.OrganizationRepository, storage domain.FileStorage, mailer domain.MailSender, policy *authz.Policy, appURL string) *ApplicationUseCase {
+	return &ApplicationUseCase{
+		appRepo:       appRepo,
+		jobRepo:       jobRepo,
+		profileRepo:   profileRepo,
+		noteRepo:      noteRepo,
+		scorecardRepo: scorecardRepo,
+		interviewRepo: interviewRepo,
+		slotRepo:      slotRepo,
+		orgRepo:       orgRepo,
+		pipelines:     pipelines{repo: pipelineRepo},
+		storage:       storage,
+		mailer:        mailer,
+		policy:        policy,
+		appURL:        appURL,
+	}
 }
 
-func (uc *ApplicationUseCase) Apply(input dto.ApplyJobInputDTO) (*dto.ApplyJobOutputDTO, error) {
+func (uc *ApplicationUseCase) Apply(subject authz.Subject, input dto.ApplyJobInputDTO) (*dto.ApplyJobOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationCreate, nil); err != nil {
+		return nil, err
+	}
+
 	job, err := uc.jobRepo.FindByID(input.JobID)
 	if err != nil {
 		return nil, errors.New("job not found")
 	}
 
-	if job.Status != "OPEN" {
+	if !job.AcceptsApplications(time.Now()) {
 		return nil, errors.New("applications are only allowed for OPEN jobs")
 	}
 
-	exists, err := uc.appRepo.Exists(input.JobID, input.CandidateID)
+	exists, err := uc.appRepo.Exists(input.JobID, subject.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -34,14 +69,51 @@ func (uc *ApplicationUseCase) Apply(input dto.ApplyJobInputDTO) (*dto.ApplyJobOu
 		return nil, errors.New("already applied to this job")
 	}
 
+	if input.Resume == nil {
+		return nil, errors.New("resume is required")
+	}
+
+	screening, err := answerQuestions(job.Questions, input.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	stages, err := uc.pipelines.forJob(job)
+	if err != nil {
+		return nil, err
+	}
+	first := firstStage(stages)
+
 	app := &domain.Application{
 		JobID:       input.JobID,
-		CandidateID: input.CandidateID,
+		CandidateID: subject.UserID,
 		Status:      domain.StatusPending,
+		StageID:     &first.ID,
+		Stage:       first,
+		Flagged:     screening.flagged,
+		Answers:     screening.answers,
+	}
+	events := []*domain.ApplicationEvent{newApplicationEvent(app, domain.EventApplied, &subject.UserID, "", nil, "")}
+
+	// A failed REJECT knockout still records the application, so recruiters
+	// can review it, but it leaves the pipeline immediately.
+	if q := screening.rejectBy; q != nil {
+		rejected := stageOfKind(stages, domain.StageKindRejected)
+		now := time.Now()
+		app.Status = domain.StatusRejected
+		app.StageID = &rejected.ID
+		app.Stage = rejected
+		app.StageChangedAt = &now
+		events = append(events, newApplicationEvent(app, domain.EventRejected, nil, domain.StatusPending, first, "knockout: "+q.Prompt))
 	}
 
-	err = uc.appRepo.Create(app)
+	if err := uc.storeAttachments(app, input); err != nil {
+		return nil, err
+	}
+
+	err = uc.appRepo.CreateWithEvents(app, events...)
 	if err != nil {
+		uc.discardAttachments(app.Attachments)
 		return nil, err
 	}
 
@@ -50,11 +122,17 @@ func (uc *ApplicationUseCase) Apply(input dto.ApplyJobInputDTO) (*dto.ApplyJobOu
 		JobID:       app.JobID,
 		CandidateID: app.CandidateID,
 		Status:      string(app.Status),
+		StageID:     stageID(app.Stage),
+		Stage:       stageName(app.Stage),
 		AppliedAt:   app.CreatedAt.Format("2006-01-02"),
 	}, nil
 }
 
-func (uc *ApplicationUseCase) GetMyApplications(candidateID uint, input dto.PaginationInputDTO) (*dto.PaginatedApplicationsOutputDTO, error) {
+func (uc *ApplicationUseCase) GetMyApplications(subject authz.Subject, input dto.PaginationInputDTO) (*dto.PaginatedApplicationsOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationListMine, nil); err != nil {
+		return nil, err
+	}
+
 	page := input.Page
 	if page <= 0 {
 		page = 1
@@ -64,7 +142,7 @@ func (uc *ApplicationUseCase) GetMyApplications(candidateID uint, input dto.Pagi
 		limit = 10
 	}
 
-	apps, total, err := uc.appRepo.FindByCandidateID(candidateID, page, limit)
+	apps, total, err := uc.appRepo.FindByCandidateID(subject.UserID, page, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +158,8 @@ func (uc *ApplicationUseCase) GetMyApplications(candidateID uint, input dto.Pagi
 			Location:    a.Job.Location,
 			CandidateID: a.CandidateID,
 			Status:      string(a.Status),
+			StageID:     stageID(a.Stage),
+			Stage:       stageName(a.Stage),
 			AppliedAt:   a.CreatedAt.Format("2006-01-02"),
 		}
 	}
@@ -97,7 +177,16 @@ func (uc *ApplicationUseCase) GetMyApplications(candidateID uint, input dto.Pagi
 	}, nil
 }
 
-func (uc *ApplicationUseCase) GetJobApplications(jobID uint, input dto.PaginationInputDTO) (*dto.PaginatedApplicationsOutputDTO, error) {
+func (uc *ApplicationUseCase) GetJobApplications(subject authz.Subject, jobID uint, input dto.JobApplicationsInputDTO) (*dto.PaginatedApplicationsOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionJobViewApplications, job); err != nil {
+		return nil, err
+	}
+
 	page := input.Page
 	if page <= 0 {
 		page = 1
@@ -107,11 +196,53 @@ func (uc *ApplicationUseCase) GetJobApplications(jobID uint, input dto.Paginatio
 		limit = 10
 	}
 
-	apps, total, err := uc.appRepo.FindPaginatedByJobID(jobID, page, limit, input.Status)
+	filter := domain.ApplicationFilter{
+		Status:   input.Status,
+		MinScore: input.MinScore,
+		MaxScore: input.MaxScore,
+		Sort:     domain.ApplicationSort(input.Sort),
+		ScoredBy: subject.UserID,
+	}
+	switch filter.Sort {
+	case domain.ApplicationSortNewest, domain.ApplicationSortScoreDesc, domain.ApplicationSortScoreAsc:
+	default:
+		return nil, errors.New("sort must be score_desc or score_asc")
+	}
+	if filter.MinScore != nil && filter.MaxScore != nil && *filter.MinScore > *filter.MaxScore {
+		return nil, errors.New("min_score cannot be greater than max_score")
+	}
+
+	apps, total, err := uc.appRepo.FindPaginatedByJobID(jobID, page, limit, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	appIDs := make([]uint, len(apps))
+	candidateIDs := make([]uint, len(apps))
+	for i, a := range apps {
+		appIDs[i] = a.ID
+		candidateIDs[i] = a.CandidateID
+	}
+	profiles, err := uc.profileRepo.FindByUserIDs(candidateIDs)
+	if err != nil {
+		return nil, err
+	}
+	notes, err := uc.noteRepo.FindByApplicationIDs(appIDs)
+	if err != nil {
+		return nil, err
+	}
+	threads := noteThreads(notes, subject.UserID)
+	scorecards, err := uc.scorecardRepo.FindByApplicationIDs(appIDs)
+	if err != nil {
+		return nil, err
+	}
+	scores := summarizeScores(scorecards, subject.UserID)
+	summaries := make(map[uint]*dto.ProfileSummaryDTO, len(profiles))
+	now := time.Now()
+	for i := range profiles {
+		summaries[profiles[i].UserID] = toProfileSummary(&profiles[i], now)
+	}
+
 	output := make([]dto.ApplyJobOutputDTO, len(apps))
 	for i, a := range apps {
 		output[i] = dto.ApplyJobOutputDTO{
@@ -121,7 +252,20 @@ func (uc *ApplicationUseCase) GetJobApplications(jobID uint, input dto.Paginatio
 			CandidateID:   a.CandidateID,
 			CandidateName: a.Candidate.Name,
 			Status:        string(a.Status),
+			StageID:       stageID(a.Stage),
+			Stage:         stageName(a.Stage),
 			AppliedAt:     a.CreatedAt.Format("2006-01-02"),
+			Flagged:       a.Flagged,
+			Answers:       toAnswerOutputs(a.Answers),
+			Attachments:   uc.signedAttachments(a.Attachments),
+			Profile:       summaries[a.CandidateID],
+			Notes:         threads[a.ID],
+		}
+		if summary := scores[a.ID]; summary != nil {
+			output[i].ScorecardCount = summary.count
+			if summary.submitted {
+				output[i].AverageScore = summary.average()
+			}
 		}
 	}
 
@@ -138,31 +282,155 @@ func (uc *ApplicationUseCase) GetJobApplications(jobID uint, input dto.Paginatio
 	}, nil
 }
 
-func (uc *ApplicationUseCase) CancelApplication(appID, candidateID uint) error {
+func (uc *ApplicationUseCase) CancelApplication(subject authz.Subject, appID uint, input dto.CancelApplicationInputDTO) error {
 	app, err := uc.appRepo.FindByID(appID)
 	if err != nil {
 		return err
 	}
 
-	if app.CandidateID != candidateID {
-		return errors.New("unauthorized: application does not belong to user")
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationCancel, app); err != nil {
+		return err
 	}
 
 	if app.Status != domain.StatusPending {
 		return errors.New("only pending applications can be canceled")
 	}
 
+	fromStatus := app.Status
 	app.Status = domain.StatusCanceled
-	return uc.appRepo.Update(app)
+	event := newApplicationEvent(app, domain.EventCanceled, &subject.UserID, fromStatus, app.Stage, input.Reason)
+	return uc.appRepo.UpdateWithEvent(app, event)
 }
 
-func (uc *ApplicationUseCase) GetCandidateStats(candidateID uint) (*dto.DashboardStatsDTO, error) {
-	applied, err := uc.appRepo.GetStats(candidateID)
+// MoveApplication moves an active application to another stage of its job's
+// pipeline. Applications only move forward, may be rejected from any stage,
+// and are hired through FinalizeJob.
+func (uc *ApplicationUseCase) MoveApplication(subject authz.Subject, appID uint, input dto.MoveApplicationInputDTO) (*dto.ApplyJobOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(appID)
 	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationMove, app); err != nil {
 		return nil, err
 	}
 
-	pending, err := uc.appRepo.GetPendingCount(candidateID)
+	if app.Status != domain.StatusPending {
+		return nil, errors.New("only pending applications can be moved")
+	}
+
+	stages, err := uc.pipelines.forJob(&app.Job)
+	if err != nil {
+		return nil, err
+	}
+
+	target := findStage(stages, input.StageID)
+	if target == nil {
+		return nil, errors.New("stage does not belong to this job's pipeline")
+	}
+
+	current := firstStage(stages)
+	if app.StageID != nil {
+		if s := findStage(stages, *app.StageID); s != nil {
+			current = s
+		}
+	}
+
+	switch {
+	case target.Kind == domain.StageKindHired:
+		return nil, errors.New("use the finalize endpoint to hire a candidate")
+	case target.ID == current.ID:
+		return nil, errors.New("application is already in this stage")
+	case target.Kind == domain.StageKindActive && target.Position < current.Position:
+		return nil, errors.New("applications can only move forward in the pipeline")
+	}
+
+	fromStatus := app.Status
+	eventType := domain.EventStageChanged
+	if target.Kind == domain.StageKindRejected {
+		app.Status = domain.StatusRejected
+		eventType = domain.EventRejected
+	}
+
+	now := time.Now()
+	app.StageID = &target.ID
+	app.Stage = target
+	app.StageChangedAt = &now
+	app.StageChangedByID = &subject.UserID
+	event := newApplicationEvent(app, eventType, &subject.UserID, fromStatus, current, input.Reason)
+	if err := uc.appRepo.UpdateWithEvent(app, event); err != nil {
+		return nil, err
+	}
+
+	return &dto.ApplyJobOutputDTO{
+		ID:          app.ID,
+		JobID:       app.JobID,
+		JobTitle:    app.Job.Title,
+		CandidateID: app.CandidateID,
+		Status:      string(app.Status),
+		StageID:     target.ID,
+		Stage:       target.Name,
+		AppliedAt:   app.CreatedAt.Format("2006-01-02"),
+	}, nil
+}
+
+// GetTimeline returns the history of an application, oldest first. Candidates
+// see every transition of their own application, but not who made it or why
+// unless they made it themselves.
+func (uc *ApplicationUseCase) GetTimeline(subject authz.Subject, appID uint) ([]dto.ApplicationEventOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(appID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationTimeline, app); err != nil {
+		return nil, err
+	}
+
+	events, err := uc.appRepo.FindEvents(app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.ApplicationEventOutputDTO, len(events))
+	for i, e := range events {
+		output[i] = dto.ApplicationEventOutputDTO{
+			ID:         e.ID,
+			Type:       string(e.Type),
+			FromStatus: string(e.FromStatus),
+			ToStatus:   string(e.ToStatus),
+			FromStage:  e.FromStage,
+			ToStage:    e.ToStage,
+			CreatedAt:  e.CreatedAt.Format(time.RFC3339),
+		}
+
+		ownEvent := e.ActorID != nil && *e.ActorID == subject.UserID
+		if subject.IsCandidate() && !ownEvent {
+			continue
+		}
+		output[i].Reason = e.Reason
+		if e.ActorID != nil {
+			output[i].ActorID = *e.ActorID
+		}
+		if e.Actor != nil {
+			output[i].ActorName = e.Actor.Name
+		}
+	}
+
+	return output, nil
+}
+
+func (uc *ApplicationUseCase) GetCandidateStats(subject authz.Subject) (*dto.DashboardStatsDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionDashboardView, nil); err != nil {
+		return nil, err
+	}
+
+	applied, err := uc.appRepo.GetStats(subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := uc.appRepo.GetPendingCount(subject.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -172,3 +440,92 @@ func (uc *ApplicationUseCase) GetCandidateStats(candidateID uint) (*dto.Dashboar
 		Pending: pending,
 	}, nil
 }
+
+// storeAttachments uploads the resume and optional cover letter of a new
+// application, removing whatever was already stored if one of them fails.
+func (uc *ApplicationUseCase) storeAttachments(app *domain.Application, input dto.ApplyJobInputDTO) error {
+	files := []struct {
+		kind  domain.AttachmentKind
+		label string
+		file  *dto.FileInputDTO
+	}{
+		{domain.AttachmentResume, "resume", input.Resume},
+		{domain.AttachmentCoverLetter, "cover letter", input.CoverLetter},
+	}
+
+	for _, f := range files {
+		if f.file == nil {
+			continue
+		}
+		attachment, err := newAttachment(uc.storage, f.kind, f.label, app.JobID, app.CandidateID, f.file)
+		if err != nil {
+			uc.discardAttachments(app.Attachments)
+			app.Attachments = nil
+			return err
+		}
+		app.Attachments = append(app.Attachments, *attachment)
+	}
+	return nil
+}
+
+func (uc *ApplicationUseCase) discardAttachments(attachments []domain.ApplicationAttachment) {
+	for _, a := range attachments {
+		if err := uc.storage.Delete(a.StorageKey); err != nil {
+			log.Println("Application: failed to delete orphaned attachment", a.StorageKey+":", err)
+		}
+	}
+}
+
+// signedAttachments describes attachments for recruiters, with download links
+// that expire after attachmentURLTTL.
+func (uc *ApplicationUseCase) signedAttachments(attachments []domain.ApplicationAttachment) []dto.AttachmentOutputDTO {
+	output := make([]dto.AttachmentOutputDTO, len(attachments))
+	expiresAt := time.Now().Add(attachmentURLTTL)
+	for i, a := range attachments {
+		output[i] = dto.AttachmentOutputDTO{
+			ID:          a.ID,
+			Kind:        string(a.Kind),
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+		}
+		url, err := uc.storage.SignedURL(a.StorageKey, a.Filename, attachmentURLTTL)
+		if err != nil {
+			log.Println("Application: failed to sign attachment URL:", err)
+			continue
+		}
+		output[i].URL = url
+		output[i].ExpiresAt = expiresAt.Format(time.RFC3339)
+	}
+	return output
+}
+
+func stageID(stage *domain.PipelineStage) uint {
+	if stage == nil {
+		return 0
+	}
+	return stage.ID
+}
+
+func stageName(stage *domain.PipelineStage) string {
+	if stage == nil {
+		return ""
+	}
+	return stage.Name
+}
+
+// newApplicationEvent describes the transition app just went through; the
+// target status and stage are read from app itself. A nil actor marks changes
+// made by the system.
+func newApplicationEvent(app *domain.Application, eventType domain.ApplicationEventType, actorID *uint, fromStatus domain.ApplicationStatus, fromStage *domain.PipelineStage, reason string) *domain.ApplicationEvent {
+	return &domain.ApplicationEvent{
+		ApplicationID: app.ID,
+		Type:          eventType,
+		ActorID:       actorID,
+		FromStatus:    fromStatus,
+		ToStatus:      app.Status,
+		FromStage:     stageName(fromStage),
+		ToStage:       stageName(app.Stage),
+		Reason:        strings.TrimSpace(reason),
+	}
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const (
+	maxAttachmentSize = 5 << 20
+
+	mimePDF  = "application/pdf"
+	mimeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+)
+
+var attachmentTypes = map[string]string{
+	".pdf":  mimePDF,
+	".docx": mimeDOCX,
+}
+
+// checkAttachment validates an uploaded document and returns its content type.
+// The type comes from the file's bytes, never from what the client declared:
+// the extension must agree with what the content actually is.
+func checkAttachment(label string, file *dto.FileInputDTO) (string, error) {
+	if len(file.Data) == 0 {
+		return "", fmt.Errorf("%s is empty", label)
+	}
+	if len(file.Data) > maxAttachmentSize {
+		return "", fmt.Errorf("%s must be at most %d MB", label, maxAttachmentSize>>20)
+	}
+
+	contentType, ok := attachmentTypes[strings.ToLower(filepath.Ext(file.Filename))]
+	if !ok {
+		return "", fmt.Errorf("%s must be a PDF or DOCX file", label)
+	}
+
+	valid := false
+	switch contentType {
+	case mimePDF:
+		valid = bytes.HasPrefix(file.Data, []byte("%PDF-"))
+	case mimeDOCX:
+		valid = isDOCX(file.Data)
+	}
+	if !valid {
+		return "", fmt.Errorf("%s content does not match its %s extension", label, filepath.Ext(file.Filename))
+	}
+
+	return contentType, nil
+}
+
+func isDOCX(data []byte) bool {
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return false
+	}
+	for _, f := range r.File {
+		if f.Name == "word/document.xml" {
+			return true
+		}
+	}
+	return false
+}
+
+// newAttachment checks file and stores it under the application's job. The
+// caller owns the stored object until the attachment row is saved.
+func newAttachment(storage domain.FileStorage, kind domain.AttachmentKind, label string, jobID, candidateID uint, file *dto.FileInputDTO) (*domain.ApplicationAttachment, error) {
+	contentType, err := checkAttachment(label, file)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix, err := randomToken(8)
+	if err != nil {
+		return nil, err
+	}
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	key := fmt.Sprintf("applications/%d/%d/%s-%s%s", jobID, candidateID, strings.ToLower(string(kind)), suffix, ext)
+
+	if err := storage.Put(key, file.Data, contentType); err != nil {
+		return nil, errors.New("failed to store " + label)
+	}
+
+	return &domain.ApplicationAttachment{
+		Kind:        kind,
+		Filename:    cleanFilename(file.Filename, string(kind)+ext),
+		ContentType: contentType,
+		Size:        int64(len(file.Data)),
+		StorageKey:  key,
+	}, nil
+}
+
+// cleanFilename keeps the name the candidate uploaded, minus any directory
+// part and control characters, for use in download headers.
+func cleanFilename(name, fallback string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "" || name == "." || name == "/" {
+		return fallback
+	}
+	return name
+}
@@ -1,7 +1,12 @@
 package usecase
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/helberthlucas14/internal/domain"
@@ -12,23 +17,40 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 7 * 24 * time.Hour
+	resetTokenTTL   = time.Hour
+	verifyTokenTTL  = 48 * time.Hour
+)
+
 type AuthUseCase struct {
-	userRepo  domain.UserRepository
-	jwtSecret string
+	userRepo      domain.UserRepository
+	tokenRepo     domain.RefreshTokenRepository
+	userTokenRepo domain.UserTokenRepository
+	mailer        domain.MailSender
+	jwtSecret     string
+	appURL        string
 }
 
-func NewAuthUseCase(userRepo domain.UserRepository, jwtSecret string) *AuthUseCase {
+func NewAuthUseCase(userRepo domain.UserRepository, tokenRepo domain.RefreshTokenRepository, userTokenRepo domain.UserTokenRepository, mailer domain.MailSender, jwtSecret, appURL string) *AuthUseCase {
 	return &AuthUseCase{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
+		userRepo:      userRepo,
+		tokenRepo:     tokenRepo,
+		userTokenRepo: userTokenRepo,
+		mailer:        mailer,
+		jwtSecret:     jwtSecret,
+		appURL:        appURL,
 	}
 }
 
 type Claims struct {
-	UserID uint        `json:"user_id"`
-	Email  string      `json:"email"`
-	Name   string      `json:"name"`
-	Role   domain.Role `json:"role"`
+	UserID    uint        `json:"user_id"`
+	Email     string      `json:"email"`
+	Name      string      `json:"name"`
+	Role      domain.Role `json:"role"`
+	Verified  bool        `json:"email_verified"`
+	SessionID string      `json:"sid"`
 	jwt.RegisteredClaims
 }
 
@@ -49,11 +71,16 @@ func (uc *AuthUseCase) Register(input dto.RegisterInputDTO) (*dto.RegisterOutput
 		return nil, err
 	}
 
+	if err := uc.sendVerificationEmail(user); err != nil {
+		log.Println("Auth: failed to send verification email:", err)
+	}
+
 	return &dto.RegisterOutputDTO{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-		Role:  user.Role,
+		ID:            user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		Role:          user.Role,
+		EmailVerified: user.IsEmailVerified(),
 	}, nil
 }
 
@@ -67,12 +94,194 @@ func (uc *AuthUseCase) Login(input dto.LoginInputDTO) (*dto.LoginOutputDTO, erro
 		return nil, errors.New("invalid credentials")
 	}
 
-	expirationTime := time.Now().Add(24 * time.Hour)
+	sessionID, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.issueTokens(user, sessionID)
+}
+
+func (uc *AuthUseCase) Refresh(refreshToken string) (*dto.LoginOutputDTO, error) {
+	stored, err := uc.tokenRepo.FindByHash(hashToken(refreshToken))
+	if err != nil {
+		return nil, errors.New("invalid refresh token")
+	}
+
+	if stored.RevokedAt == nil && !stored.IsActive(time.Now()) {
+		return nil, errors.New("refresh token expired")
+	}
+
+	revoked, err := uc.tokenRepo.Revoke(stored.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		// The token was already rotated: someone is replaying it, so kill the whole session.
+		if err := uc.tokenRepo.RevokeSession(stored.SessionID); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("refresh token reuse detected, session revoked")
+	}
+
+	user, err := uc.userRepo.FindByID(stored.UserID)
+	if err != nil {
+		return nil, errors.New("invalid refresh token")
+	}
+
+	return uc.issueTokens(user, stored.SessionID)
+}
+
+func (uc *AuthUseCase) Logout(sessionID string) error {
+	return uc.tokenRepo.RevokeSession(sessionID)
+}
+
+func (uc *AuthUseCase) ForgotPassword(email string) error {
+	user, err := uc.userRepo.FindByEmail(email)
+	if err != nil {
+		// Do not reveal whether the email is registered.
+		return nil
+	}
+
+	if err := uc.userTokenRepo.InvalidateForUser(user.ID, domain.TokenPurposePasswordReset); err != nil {
+		return err
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		return err
+	}
+
+	err = uc.userTokenRepo.Create(&domain.UserToken{
+		UserID:    user.ID,
+		Purpose:   domain.TokenPurposePasswordReset,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(resetTokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = uc.mailer.Send(domain.MailMessage{
+		To:      []string{user.Email},
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nWe received a request to reset your password. Use the link below within %d minutes:\n\n%s/reset-password?token=%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			user.Name, int(resetTokenTTL.Minutes()), uc.appURL, token),
+	})
+	if err != nil {
+		log.Println("Auth: failed to send password reset email:", err)
+	}
+
+	return nil
+}
+
+func (uc *AuthUseCase) ResetPassword(input dto.ResetPasswordInputDTO) error {
+	stored, err := uc.userTokenRepo.FindByHash(hashToken(input.Token), domain.TokenPurposePasswordReset)
+	if err != nil || !stored.IsUsable(time.Now()) {
+		return errors.New("invalid or expired reset token")
+	}
+
+	used, err := uc.userTokenRepo.MarkUsed(stored.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errors.New("invalid or expired reset token")
+	}
+
+	user, err := uc.userRepo.FindByID(stored.UserID)
+	if err != nil {
+		return errors.New("invalid or expired reset token")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	user.Password = string(hashedPassword)
+	if err := uc.userRepo.Update(user); err != nil {
+		return err
+	}
+
+	return uc.tokenRepo.RevokeAllForUser(user.ID)
+}
+
+func (uc *AuthUseCase) VerifyEmail(token string) error {
+	stored, err := uc.userTokenRepo.FindByHash(hashToken(token), domain.TokenPurposeEmailVerification)
+	if err != nil || !stored.IsUsable(time.Now()) {
+		return errors.New("invalid or expired verification token")
+	}
+
+	used, err := uc.userTokenRepo.MarkUsed(stored.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errors.New("invalid or expired verification token")
+	}
+
+	user, err := uc.userRepo.FindByID(stored.UserID)
+	if err != nil {
+		return errors.New("invalid or expired verification token")
+	}
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	now := time.Now()
+	user.EmailVerifiedAt = &now
+	return uc.userRepo.Update(user)
+}
+
+func (uc *AuthUseCase) ResendVerification(email string) error {
+	user, err := uc.userRepo.FindByEmail(email)
+	if err != nil || user.IsEmailVerified() {
+		// Do not reveal whether the email is registered or already verified.
+		return nil
+	}
+
+	return uc.sendVerificationEmail(user)
+}
+
+func (uc *AuthUseCase) sendVerificationEmail(user *domain.User) error {
+	if err := uc.userTokenRepo.InvalidateForUser(user.ID, domain.TokenPurposeEmailVerification); err != nil {
+		return err
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		return err
+	}
+
+	err = uc.userTokenRepo.Create(&domain.UserToken{
+		UserID:    user.ID,
+		Purpose:   domain.TokenPurposeEmailVerification,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(verifyTokenTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	return uc.mailer.Send(domain.MailMessage{
+		To:      []string{user.Email},
+		Subject: "Confirm your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below within %d hours:\n\n%s/verify-email?token=%s\n\nYou need a verified email to apply to or post jobs.\n",
+			user.Name, int(verifyTokenTTL.Hours()), uc.appURL, token),
+	})
+}
+
+func (uc *AuthUseCase) issueTokens(user *domain.User, sessionID string) (*dto.LoginOutputDTO, error) {
+	expirationTime := time.Now().Add(accessTokenTTL)
 	claims := &Claims{
-		UserID: user.ID,
-		Email:  user.Email,
-		Name:   user.Name,
-		Role:   user.Role,
+		UserID:    user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Role:      user.Role,
+		Verified:  user.IsEmailVerified(),
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			Issuer:    "recruitment-system",
@@ -85,5 +294,37 @@ func (uc *AuthUseCase) Login(input dto.LoginInputDTO) (*dto.LoginOutputDTO, erro
 		return nil, err
 	}
 
-	return &dto.LoginOutputDTO{Token: tokenString}, nil
+	refreshToken, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.tokenRepo.Create(&domain.RefreshToken{
+		UserID:    user.ID,
+		SessionID: sessionID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: time.Now().Add(refreshTokenTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.LoginOutputDTO{
+		Token:        tokenString,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(accessTokenTTL.Seconds()),
+	}, nil
+}
+
+func randomToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -0,0 +1,448 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+// These tests call each protected route's use case the way its handler does,
+// with repositories that only find the fixtures below. A subject the use case
+// lets through runs into a nil repository past the authorization check, which
+// is recovered and counted as allowed, so a wrong action or a missing
+// Authorize call shows up as a subject allowed where it should be refused.
+
+const (
+	orgID      uint = 100
+	otherOrgID uint = 200
+
+	soloJobID uint = 1 // a DRAFT job of soloRecruiter, outside any organization
+	orgJobID  uint = 2 // an OPEN job of organization orgID
+
+	// candidate's applications to those jobs, and the notes, interviews and
+	// offers on them, share their job's ID.
+	soloAppID = soloJobID
+	orgAppID  = orgJobID
+)
+
+// Recruiters carry no memberships: the policy loads them from orgRepoStub.
+var (
+	candidate      = authz.Subject{UserID: 1, Role: domain.RoleCandidate}
+	otherCandidate = authz.Subject{UserID: 2, Role: domain.RoleCandidate}
+	soloRecruiter  = authz.Subject{UserID: 10, Role: domain.RoleRecruiter}
+	otherSolo      = authz.Subject{UserID: 11, Role: domain.RoleRecruiter}
+	orgRecruiter   = authz.Subject{UserID: 20, Role: domain.RoleRecruiter}
+	orgOwner       = authz.Subject{UserID: 21, Role: domain.RoleRecruiter}
+	hiringManager  = authz.Subject{UserID: 22, Role: domain.RoleRecruiter}
+	foreignOwner   = authz.Subject{UserID: 30, Role: domain.RoleRecruiter}
+	admin          = authz.Subject{UserID: 40, Role: domain.RoleAdmin}
+	anonymous      = authz.Subject{}
+)
+
+var memberships = map[uint][]domain.OrganizationMember{
+	orgRecruiter.UserID:  {{OrganizationID: orgID, Role: domain.OrgRoleRecruiter}},
+	orgOwner.UserID:      {{OrganizationID: orgID, Role: domain.OrgRoleOwner}},
+	hiringManager.UserID: {{OrganizationID: orgID, Role: domain.OrgRoleHiringManager}},
+	foreignOwner.UserID:  {{OrganizationID: otherOrgID, Role: domain.OrgRoleOwner}},
+}
+
+func findJob(id uint) (*domain.Job, error) {
+	switch id {
+	case soloJobID:
+		return &domain.Job{ID: id, RecruiterID: soloRecruiter.UserID, Status: domain.JobStatusDraft}, nil
+	case orgJobID:
+		org := orgID
+		return &domain.Job{ID: id, RecruiterID: orgRecruiter.UserID, OrganizationID: &org, Status: domain.JobStatusOpen}, nil
+	}
+	return nil, errors.New("record not found")
+}
+
+// Application i is candidate's application to job i.
+func findApplication(id uint) (*domain.Application, error) {
+	job, err := findJob(id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Application{ID: id, JobID: job.ID, Job: *job, CandidateID: candidate.UserID, Status: domain.StatusPending}, nil
+}
+
+type jobRepoStub struct{ domain.JobRepository }
+
+func (jobRepoStub) FindByID(id uint) (*domain.Job, error)        { return findJob(id) }
+func (jobRepoStub) FindDeletedByID(id uint) (*domain.Job, error) { return findJob(id) }
+
+type appRepoStub struct{ domain.ApplicationRepository }
+
+func (appRepoStub) FindByID(id uint) (*domain.Application, error) { return findApplication(id) }
+
+// Note i is orgRecruiter's note on application i.
+type noteRepoStub struct {
+	domain.ApplicationNoteRepository
+}
+
+func (noteRepoStub) FindByID(id uint) (*domain.ApplicationNote, error) {
+	app, err := findApplication(id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ApplicationNote{ID: id, ApplicationID: app.ID, Application: *app, AuthorID: orgRecruiter.UserID}, nil
+}
+
+// Interview i is scheduled for application i.
+type interviewRepoStub struct{ domain.InterviewRepository }
+
+func (interviewRepoStub) FindByID(id uint) (*domain.Interview, error) {
+	app, err := findApplication(id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Interview{ID: id, ApplicationID: app.ID, Application: *app, Status: domain.InterviewScheduled}, nil
+}
+
+// Offer i is made on application i.
+type offerRepoStub struct{ domain.OfferRepository }
+
+func (offerRepoStub) FindByID(id uint) (*domain.Offer, error) {
+	app, err := findApplication(id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Offer{ID: id, ApplicationID: app.ID, Application: *app, Status: domain.OfferSent}, nil
+}
+
+// Every job awaits orgRecruiter's approval request.
+type approvalRepoStub struct{ domain.JobApprovalRepository }
+
+func (approvalRepoStub) FindPendingByJobID(jobID uint) (*domain.JobApproval, error) {
+	return &domain.JobApproval{JobID: jobID, OrganizationID: orgID, RequestedByID: orgRecruiter.UserID, Status: domain.ApprovalPending}, nil
+}
+
+type orgRepoStub struct{ domain.OrganizationRepository }
+
+func (orgRepoStub) FindByID(id uint) (*domain.Organization, error) {
+	return &domain.Organization{ID: id}, nil
+}
+
+func (orgRepoStub) FindByUserID(userID uint) ([]domain.OrganizationMember, error) {
+	return memberships[userID], nil
+}
+
+type useCases struct {
+	jobs       *JobUseCase
+	apps       *ApplicationUseCase
+	pipelines  *PipelineUseCase
+	orgs       *OrganizationUseCase
+	profiles   *ProfileUseCase
+	categories *CategoryUseCase
+}
+
+func newUseCases() useCases {
+	orgRepo := orgRepoStub{}
+	policy := authz.NewPolicy(orgRepo)
+	return useCases{
+		jobs:       NewJobUseCase(jobRepoStub{}, appRepoStub{}, orgRepo, nil, nil, approvalRepoStub{}, offerRepoStub{}, nil, nil, policy, ""),
+		apps:       NewApplicationUseCase(appRepoStub{}, jobRepoStub{}, nil, nil, noteRepoStub{}, nil, interviewRepoStub{}, nil, orgRepo, nil, nil, policy, ""),
+		pipelines:  NewPipelineUseCase(nil, jobRepoStub{}, orgRepo, policy),
+		orgs:       NewOrganizationUseCase(orgRepo, nil, nil, policy, ""),
+		profiles:   NewProfileUseCase(nil, policy),
+		categories: NewCategoryUseCase(nil, policy),
+	}
+}
+
+func errOf[T any](_ T, err error) error {
+	return err
+}
+
+type routeCase struct {
+	route   string
+	call    func(uc useCases, subject authz.Subject) error
+	allowed []authz.Subject
+	denied  []authz.Subject
+}
+
+func routeCases() []routeCase {
+	subjects := func(s ...authz.Subject) []authz.Subject { return s }
+
+	return []routeCase{
+		{"GET /jobs/:id", func(uc useCases, s authz.Subject) error {
+			// Unpublished jobs are hidden from those who cannot edit them.
+			if _, err := uc.jobs.GetJobByID(s, soloJobID); err != nil {
+				return fmt.Errorf("%w: %v", authz.ErrForbidden, err)
+			}
+			return nil
+		}, subjects(soloRecruiter), subjects(otherSolo, candidate, anonymous)},
+		{"GET /jobs/:id", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.GetJobByID(s, orgJobID))
+		}, subjects(anonymous, candidate, foreignOwner), nil},
+		{"POST /jobs", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.CreateJob(s, dto.CreateJobInputDTO{Company: "Acme"}))
+		}, subjects(soloRecruiter), subjects(candidate, admin, anonymous)},
+		{"POST /jobs", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.CreateJob(s, dto.CreateJobInputDTO{OrganizationID: orgID}))
+		}, subjects(orgRecruiter, hiringManager), subjects(foreignOwner, soloRecruiter)},
+		{"GET /jobs/mine", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.GetRecruiterJobs(s, dto.SearchJobsInputDTO{}))
+		}, subjects(soloRecruiter), subjects(candidate, admin)},
+		{"PATCH /jobs/:id", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.UpdateJob(s, orgJobID, dto.UpdateJobInputDTO{}))
+		}, subjects(orgRecruiter, hiringManager), subjects(foreignOwner, soloRecruiter, candidate)},
+		{"PATCH /jobs/:id", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.UpdateJob(s, soloJobID, dto.UpdateJobInputDTO{}))
+		}, subjects(soloRecruiter), subjects(otherSolo, admin)},
+		{"POST /jobs/:id/publish", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.PublishJob(s, orgJobID))
+		}, subjects(orgRecruiter), subjects(foreignOwner, candidate)},
+		{"POST /jobs/:id/submit", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.SubmitJob(s, orgJobID, ""))
+		}, subjects(orgRecruiter), subjects(foreignOwner, candidate)},
+		{"POST /jobs/:id/approve", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.DecideJob(s, dto.DecideJobInputDTO{JobID: orgJobID, Approve: true}))
+		}, subjects(orgOwner, hiringManager), subjects(orgRecruiter, foreignOwner, candidate)},
+		{"POST /jobs/:id/reject", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.DecideJob(s, dto.DecideJobInputDTO{JobID: orgJobID}))
+		}, subjects(hiringManager), subjects(orgRecruiter, foreignOwner)},
+		{"GET /jobs/:id/approvals", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.GetJobApprovals(s, orgJobID))
+		}, subjects(orgRecruiter), subjects(foreignOwner, candidate)},
+		{"GET /approvals", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.GetApprovalQueue(s, dto.PaginationInputDTO{}))
+		}, subjects(orgOwner), subjects(candidate, admin)},
+		{"POST /jobs/:id/finalize", func(uc useCases, s authz.Subject) error {
+			return uc.jobs.FinalizeJob(s, dto.FinalizeJobInputDTO{JobID: soloJobID})
+		}, subjects(soloRecruiter), subjects(otherSolo, orgOwner)},
+		{"POST /jobs/:id/hire", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.HireCandidate(s, dto.HireCandidateInputDTO{JobID: orgJobID, CandidateID: candidate.UserID}))
+		}, subjects(orgRecruiter), subjects(foreignOwner, candidate)},
+		{"POST /jobs/:id/reopen", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.ReopenJob(s, dto.ReopenJobInputDTO{JobID: soloJobID}))
+		}, subjects(soloRecruiter), subjects(otherSolo)},
+		{"POST /jobs/:id/archive", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.ArchiveJob(s, orgJobID, true))
+		}, subjects(orgOwner), subjects(foreignOwner)},
+		{"POST /jobs/:id/unarchive", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.ArchiveJob(s, soloJobID, false))
+		}, subjects(soloRecruiter), subjects(otherSolo)},
+		{"DELETE /jobs/:id", func(uc useCases, s authz.Subject) error {
+			return uc.jobs.DeleteJob(s, soloJobID)
+		}, subjects(soloRecruiter), subjects(otherSolo, admin)},
+		{"POST /jobs/:id/restore", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.RestoreJob(s, orgJobID))
+		}, subjects(orgRecruiter), subjects(foreignOwner)},
+		{"GET /jobs/:id/applications", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetJobApplications(s, orgJobID, dto.JobApplicationsInputDTO{}))
+		}, subjects(orgRecruiter, hiringManager), subjects(foreignOwner, soloRecruiter, candidate)},
+		{"GET /jobs/:id/interview-slots", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetJobInterviewSlots(s, orgJobID))
+		}, subjects(orgRecruiter), subjects(foreignOwner, candidate)},
+		{"POST /jobs/:id/interview-slots", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.PublishInterviewSlots(s, dto.PublishInterviewSlotsInputDTO{JobID: soloJobID}))
+		}, subjects(soloRecruiter), subjects(otherSolo)},
+		{"DELETE /jobs/:id/interview-slots/:slotId", func(uc useCases, s authz.Subject) error {
+			return uc.apps.DeleteInterviewSlot(s, orgJobID, 1)
+		}, subjects(orgRecruiter), subjects(foreignOwner)},
+		{"GET /jobs/:id/pipeline", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.pipelines.GetJobPipeline(s, orgJobID))
+		}, subjects(orgRecruiter), subjects(foreignOwner, candidate)},
+		{"PUT /jobs/:id/pipeline", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.pipelines.UpdateJobPipeline(s, orgJobID, dto.UpdatePipelineInputDTO{}))
+		}, subjects(orgRecruiter), subjects(foreignOwner)},
+		{"POST /applications/:id/move", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.MoveApplication(s, orgAppID, dto.MoveApplicationInputDTO{}))
+		}, subjects(orgRecruiter), subjects(foreignOwner, candidate)},
+		{"POST /applications/:id/move", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.MoveApplication(s, soloAppID, dto.MoveApplicationInputDTO{}))
+		}, subjects(soloRecruiter), subjects(otherSolo)},
+		{"GET /applications/:id/timeline", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetTimeline(s, orgAppID))
+		}, subjects(candidate, orgRecruiter), subjects(otherCandidate, foreignOwner)},
+		{"GET /applications/:id/notes", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetNotes(s, orgAppID))
+		}, subjects(orgRecruiter), subjects(candidate, foreignOwner)},
+		{"POST /applications/:id/notes", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.AddNote(s, dto.CreateNoteInputDTO{ApplicationID: orgAppID}))
+		}, subjects(hiringManager), subjects(candidate, foreignOwner)},
+		{"PATCH /applications/:id/notes/:noteId", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.UpdateNote(s, dto.UpdateNoteInputDTO{ApplicationID: orgAppID, NoteID: orgAppID}))
+		}, subjects(orgRecruiter), subjects(orgOwner, foreignOwner)},
+		{"GET /applications/:id/scorecards", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetScorecards(s, orgAppID))
+		}, subjects(orgRecruiter), subjects(candidate, foreignOwner)},
+		{"PUT /applications/:id/scorecard", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.SubmitScorecard(s, dto.SubmitScorecardInputDTO{ApplicationID: soloAppID}))
+		}, subjects(soloRecruiter), subjects(otherSolo, candidate)},
+		{"GET /applications/:id/interviews", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetInterviews(s, orgAppID))
+		}, subjects(candidate, orgRecruiter), subjects(otherCandidate, foreignOwner)},
+		{"POST /applications/:id/interviews", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.ScheduleInterview(s, dto.ScheduleInterviewInputDTO{ApplicationID: orgAppID}))
+		}, subjects(orgRecruiter), subjects(candidate, foreignOwner)},
+		{"POST /applications/:id/interviews/:interviewId/reschedule", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.RescheduleInterview(s, dto.RescheduleInterviewInputDTO{ApplicationID: soloAppID, InterviewID: soloAppID}))
+		}, subjects(soloRecruiter), subjects(otherSolo, candidate)},
+		{"POST /applications/:id/interviews/:interviewId/cancel", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.CancelInterview(s, dto.CancelInterviewInputDTO{ApplicationID: orgAppID, InterviewID: orgAppID}))
+		}, subjects(orgRecruiter), subjects(candidate, foreignOwner)},
+		{"POST /applications/:id/interviews/:interviewId/outcome", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.RecordInterviewOutcome(s, dto.InterviewOutcomeInputDTO{ApplicationID: orgAppID, InterviewID: orgAppID}))
+		}, subjects(orgRecruiter), subjects(candidate, foreignOwner)},
+		{"GET /applications/:id/interviews/:interviewId/calendar", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.InterviewCalendar(s, soloAppID, soloAppID))
+		}, subjects(candidate, soloRecruiter), subjects(otherCandidate, otherSolo)},
+		{"GET /applications/:id/offers", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.GetOffers(s, orgAppID))
+		}, subjects(candidate, orgRecruiter), subjects(otherCandidate, foreignOwner)},
+		{"POST /applications/:id/offers", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.CreateOffer(s, dto.CreateOfferInputDTO{ApplicationID: orgAppID}))
+		}, subjects(orgRecruiter), subjects(candidate, foreignOwner)},
+		{"PATCH /offers/:id", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.UpdateOffer(s, dto.UpdateOfferInputDTO{OfferID: soloAppID}))
+		}, subjects(soloRecruiter), subjects(otherSolo, candidate)},
+		{"POST /offers/:id/send", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.SendOffer(s, orgAppID))
+		}, subjects(orgOwner), subjects(candidate, foreignOwner)},
+		{"GET /offers/:id/letter", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.OfferLetter(s, orgAppID))
+		}, subjects(candidate, orgRecruiter), subjects(otherCandidate, foreignOwner)},
+		{"POST /organizations", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.Create(s, dto.CreateOrganizationInputDTO{Name: "Acme"}))
+		}, subjects(soloRecruiter), subjects(candidate, admin)},
+		{"GET /organizations/mine", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.GetMyOrganizations(s))
+		}, subjects(orgRecruiter), subjects(candidate)},
+		{"PATCH /organizations/:id", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.Update(s, orgID, dto.UpdateOrganizationInputDTO{}))
+		}, subjects(orgOwner), subjects(orgRecruiter, foreignOwner)},
+		{"GET /organizations/:id/members", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.GetMembers(s, orgID))
+		}, subjects(orgRecruiter, hiringManager), subjects(foreignOwner, candidate)},
+		{"PATCH /organizations/:id/members/:userId", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.UpdateMemberRole(s, dto.UpdateMemberInputDTO{OrganizationID: orgID, UserID: orgRecruiter.UserID}))
+		}, subjects(orgOwner), subjects(hiringManager, foreignOwner)},
+		{"DELETE /organizations/:id/members/:userId", func(uc useCases, s authz.Subject) error {
+			return uc.orgs.RemoveMember(s, orgID, orgRecruiter.UserID)
+		}, subjects(orgOwner), subjects(orgRecruiter, foreignOwner)},
+		{"POST /organizations/:id/invitations", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.Invite(s, dto.InviteMemberInputDTO{OrganizationID: orgID}))
+		}, subjects(orgOwner), subjects(orgRecruiter, foreignOwner)},
+		{"GET /organizations/:id/invitations", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.GetPendingInvitations(s, orgID))
+		}, subjects(hiringManager), subjects(foreignOwner)},
+		{"DELETE /organizations/:id/invitations/:invitationId", func(uc useCases, s authz.Subject) error {
+			return uc.orgs.RevokeInvitation(s, orgID, 1)
+		}, subjects(orgOwner), subjects(hiringManager, foreignOwner)},
+		{"GET /organizations/:id/pipeline", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.pipelines.GetOrganizationPipeline(s, orgID))
+		}, subjects(orgRecruiter), subjects(foreignOwner)},
+		{"PUT /organizations/:id/pipeline", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.pipelines.UpdateOrganizationPipeline(s, orgID, dto.UpdatePipelineInputDTO{}))
+		}, subjects(orgOwner), subjects(orgRecruiter, foreignOwner)},
+		{"POST /invitations/accept", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.orgs.AcceptInvitation(s, "token"))
+		}, subjects(soloRecruiter), subjects(candidate)},
+		{"POST /jobs/:id/apply", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.Apply(s, dto.ApplyJobInputDTO{JobID: orgJobID}))
+		}, subjects(candidate), subjects(soloRecruiter, admin)},
+		{"GET /applications", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetMyApplications(s, dto.PaginationInputDTO{}))
+		}, subjects(candidate), subjects(orgRecruiter)},
+		{"PATCH /applications/:id/cancel", func(uc useCases, s authz.Subject) error {
+			return uc.apps.CancelApplication(s, orgAppID, dto.CancelApplicationInputDTO{})
+		}, subjects(candidate), subjects(otherCandidate, orgRecruiter)},
+		{"GET /applications/:id/interview-slots", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetOpenInterviewSlots(s, orgAppID))
+		}, subjects(candidate), subjects(otherCandidate, orgRecruiter)},
+		{"POST /applications/:id/interview-slots", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.BookInterviewSlot(s, dto.BookInterviewSlotInputDTO{ApplicationID: soloAppID}))
+		}, subjects(candidate), subjects(otherCandidate, soloRecruiter)},
+		{"POST /offers/:id/accept", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.AcceptOffer(s, orgAppID))
+		}, subjects(candidate), subjects(otherCandidate, orgRecruiter)},
+		{"POST /offers/:id/decline", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.jobs.DeclineOffer(s, orgAppID, ""))
+		}, subjects(candidate), subjects(otherCandidate, orgRecruiter)},
+		{"GET /me/profile", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.profiles.GetMyProfile(s))
+		}, subjects(candidate), subjects(soloRecruiter)},
+		{"POST /me/profile", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.profiles.CreateMyProfile(s, dto.CandidateProfileInputDTO{}))
+		}, subjects(candidate), subjects(orgRecruiter)},
+		{"PUT /me/profile", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.profiles.UpdateMyProfile(s, dto.CandidateProfileInputDTO{}))
+		}, subjects(candidate), subjects(admin)},
+		{"DELETE /me/profile", func(uc useCases, s authz.Subject) error {
+			return uc.profiles.DeleteMyProfile(s)
+		}, subjects(candidate), subjects(admin, soloRecruiter)},
+		{"POST /categories", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.categories.CreateCategory(s, dto.CategoryInputDTO{}))
+		}, subjects(admin), subjects(orgOwner, candidate)},
+		{"PATCH /categories/:id", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.categories.UpdateCategory(s, 1, dto.CategoryInputDTO{}))
+		}, subjects(admin), subjects(candidate)},
+		{"DELETE /categories/:id", func(uc useCases, s authz.Subject) error {
+			return uc.categories.DeleteCategory(s, 1)
+		}, subjects(admin), subjects(soloRecruiter)},
+		{"GET /dashboard/summary", func(uc useCases, s authz.Subject) error {
+			return errOf(uc.apps.GetCandidateStats(s))
+		}, subjects(candidate), subjects(admin)},
+	}
+}
+
+// authorized reports whether call let subject past authorization: it either
+// succeeded, failed for a reason other than ErrForbidden, or went on to use a
+// repository the test leaves nil.
+func authorized(call func(uc useCases, subject authz.Subject) error, subject authz.Subject) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = true
+		}
+	}()
+	return !errors.Is(call(newUseCases(), subject), authz.ErrForbidden)
+}
+
+func TestRouteAuthorization(t *testing.T) {
+	for _, tc := range routeCases() {
+		for _, s := range tc.allowed {
+			if !authorized(tc.call, s) {
+				t.Errorf("%s: user %d (%s) was refused, want allowed", tc.route, s.UserID, s.Role)
+			}
+		}
+		for _, s := range tc.denied {
+			if authorized(tc.call, s) {
+				t.Errorf("%s: user %d (%s) was allowed, want refused", tc.route, s.UserID, s.Role)
+			}
+		}
+	}
+}
+
+// Every protected route of the API must be driven through its use case above.
+func TestEveryProtectedRouteIsCovered(t *testing.T) {
+	source, err := os.ReadFile("../../cmd/api/main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	covered := map[string]bool{}
+	for _, tc := range routeCases() {
+		covered[tc.route] = true
+	}
+
+	// Logout only revokes the caller's own tokens.
+	unauthorized := map[string]bool{"POST /logout": true}
+
+	routes := regexp.MustCompile(`protected\.(GET|POST|PUT|PATCH|DELETE)\("([^"]+)"`).FindAllStringSubmatch(string(source), -1)
+	if len(routes) == 0 {
+		t.Fatal("no protected routes found in cmd/api/main.go")
+	}
+	for _, m := range routes {
+		route := m[1] + " " + m[2]
+		if !covered[route] && !unauthorized[route] {
+			t.Errorf("route %s has no authorization case", route)
+		}
+	}
+}
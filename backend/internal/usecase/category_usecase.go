@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+type CategoryUseCase struct {
+	categoryRepo domain.CategoryRepository
+	policy       *authz.Policy
+}
+
+func NewCategoryUseCase(categoryRepo domain.CategoryRepository, policy *authz.Policy) *CategoryUseCase {
+	return &CategoryUseCase{categoryRepo: categoryRepo, policy: policy}
+}
+
+func (uc *CategoryUseCase) ListCategories() ([]dto.CategoryOutputDTO, error) {
+	categories, err := uc.categoryRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	output := make([]dto.CategoryOutputDTO, len(categories))
+	for i := range categories {
+		output[i] = *toCategoryOutput(&categories[i])
+	}
+	return output, nil
+}
+
+func (uc *CategoryUseCase) CreateCategory(subject authz.Subject, input dto.CategoryInputDTO) (*dto.CategoryOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionCategoryManage, nil); err != nil {
+		return nil, err
+	}
+
+	category := &domain.Category{}
+	if err := uc.applyCategoryInput(category, input); err != nil {
+		return nil, err
+	}
+	if err := uc.categoryRepo.Create(category); err != nil {
+		return nil, err
+	}
+	return toCategoryOutput(category), nil
+}
+
+func (uc *CategoryUseCase) UpdateCategory(subject authz.Subject, id uint, input dto.CategoryInputDTO) (*dto.CategoryOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionCategoryManage, nil); err != nil {
+		return nil, err
+	}
+
+	category, err := uc.categoryRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("category not found")
+	}
+	if input.Name == "" {
+		input.Name = category.Name
+	}
+	if input.Slug == "" {
+		input.Slug = category.Slug
+	}
+	if err := uc.applyCategoryInput(category, input); err != nil {
+		return nil, err
+	}
+	if err := uc.categoryRepo.Update(category); err != nil {
+		return nil, err
+	}
+	return toCategoryOutput(category), nil
+}
+
+// DeleteCategory removes a category no job is filed under.
+func (uc *CategoryUseCase) DeleteCategory(subject authz.Subject, id uint) error {
+	if err := uc.policy.Authorize(subject, authz.ActionCategoryManage, nil); err != nil {
+		return err
+	}
+
+	if _, err := uc.categoryRepo.FindByID(id); err != nil {
+		return errors.New("category not found")
+	}
+	count, err := uc.categoryRepo.CountJobs(id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("category is used by %d jobs", count)
+	}
+	return uc.categoryRepo.Delete(id)
+}
+
+// applyCategoryInput validates input and copies it onto category. The slug
+// defaults to the name and must be unique.
+func (uc *CategoryUseCase) applyCategoryInput(category *domain.Category, input dto.CategoryInputDTO) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return errors.New("category name is required")
+	}
+	if len(name) > 80 {
+		return errors.New("category name must be at most 80 characters")
+	}
+
+	slug := input.Slug
+	if slug == "" {
+		slug = name
+	}
+	slug = slugify(slug)
+	if slug == "" {
+		return errors.New("category slug must contain letters or digits")
+	}
+
+	categories, err := uc.categoryRepo.FindAll()
+	if err != nil {
+		return err
+	}
+	for _, other := range categories {
+		if other.ID != category.ID && other.Slug == slug {
+			return fmt.Errorf("category slug %q is already in use", slug)
+		}
+	}
+
+	category.Name = name
+	category.Slug = slug
+	return nil
+}
+
+// slugify lowercases s, strips accents and joins the remaining letters and
+// digits with hyphens: "Operações & TI" becomes "operacoes-ti".
+func slugify(s string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), s)
+	if err != nil {
+		folded = s
+	}
+
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(folded) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		} else {
+			hyphen = true
+		}
+	}
+	return b.String()
+}
+
+func toCategoryOutput(category *domain.Category) *dto.CategoryOutputDTO {
+	if category == nil {
+		return nil
+	}
+	return &dto.CategoryOutputDTO{ID: category.ID, Slug: category.Slug, Name: category.Name}
+}
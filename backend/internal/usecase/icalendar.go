@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// calendarMethod is the iTIP method of an invitation: REQUEST creates or
+// updates the event in the attendee's calendar, CANCEL removes it.
+type calendarMethod string
+
+const (
+	calendarRequest calendarMethod = "REQUEST"
+	calendarCancel  calendarMethod = "CANCEL"
+)
+
+const calendarTimeLayout = "20060102T150405Z"
+
+type calendarPerson struct {
+	Name  string
+	Email string
+}
+
+// calendarEvent describes one VEVENT. Updates of an event must keep its UID
+// and raise its Sequence.
+type calendarEvent struct {
+	UID         string
+	Sequence    int
+	Method      calendarMethod
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Organizer   calendarPerson
+	Attendees   []calendarPerson
+}
+
+// encodeCalendar renders the event as an RFC 5545 iCalendar object, with
+// CRLF line endings, escaped text and lines folded at 75 octets.
+func encodeCalendar(event calendarEvent, now time.Time) []byte {
+	status := "CONFIRMED"
+	if event.Method == calendarCancel {
+		status = "CANCELLED"
+	}
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Recruitment System//Interviews//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:" + string(event.Method),
+		"BEGIN:VEVENT",
+		"UID:" + escapeCalendarText(event.UID),
+		fmt.Sprintf("SEQUENCE:%d", event.Sequence),
+		"DTSTAMP:" + now.UTC().Format(calendarTimeLayout),
+		"DTSTART:" + event.Start.UTC().Format(calendarTimeLayout),
+		"DTEND:" + event.End.UTC().Format(calendarTimeLayout),
+		"SUMMARY:" + escapeCalendarText(event.Summary),
+		"STATUS:" + status,
+	}
+	if event.Description != "" {
+		lines = append(lines, "DESCRIPTION:"+escapeCalendarText(event.Description))
+	}
+	if event.Location != "" {
+		lines = append(lines, "LOCATION:"+escapeCalendarText(event.Location))
+	}
+	if event.URL != "" {
+		lines = append(lines, "URL:"+event.URL)
+	}
+	lines = append(lines, fmt.Sprintf("ORGANIZER;CN=%s:mailto:%s", quoteCalendarParam(event.Organizer.Name), event.Organizer.Email))
+	for _, a := range event.Attendees {
+		lines = append(lines, fmt.Sprintf("ATTENDEE;CN=%s;ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:%s", quoteCalendarParam(a.Name), a.Email))
+	}
+	lines = append(lines, "END:VEVENT", "END:VCALENDAR")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(foldCalendarLine(line))
+		b.WriteString("\r\n")
+	}
+	return []byte(b.String())
+}
+
+var calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeCalendarText(s string) string {
+	return calendarTextEscaper.Replace(s)
+}
+
+// quoteCalendarParam quotes a parameter value such as CN, dropping the
+// characters a quoted value cannot hold.
+func quoteCalendarParam(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r == '"' || r < ' ' {
+			return -1
+		}
+		return r
+	}, s)
+	return `"` + s + `"`
+}
+
+// foldCalendarLine splits lines longer than 75 octets, continuing them on
+// lines starting with a space, without breaking UTF-8 sequences.
+func foldCalendarLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
@@ -0,0 +1,276 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const (
+	minSlotMinutes    = 15
+	maxSlotsPerWindow = 48
+	// minBookingNotice keeps candidates from booking slots the interviewer
+	// has no time to prepare for.
+	minBookingNotice = time.Hour
+)
+
+// PublishInterviewSlots splits an availability window of an interviewer into
+// consecutive slots candidates can book. Without an interviewer the subject
+// is the one available; without a stage any pending candidate may book.
+func (uc *ApplicationUseCase) PublishInterviewSlots(subject authz.Subject, input dto.PublishInterviewSlotsInputDTO) ([]dto.InterviewSlotOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(input.JobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionJobManageSlots, job); err != nil {
+		return nil, err
+	}
+
+	if input.StartsAt.IsZero() || input.EndsAt.IsZero() {
+		return nil, errors.New("starts_at and ends_at are required")
+	}
+	if !input.EndsAt.After(input.StartsAt) {
+		return nil, errors.New("ends_at must be after starts_at")
+	}
+	if !input.StartsAt.After(time.Now()) {
+		return nil, errors.New("availability must start in the future")
+	}
+	if input.DurationMinutes < minSlotMinutes || time.Duration(input.DurationMinutes)*time.Minute > maxInterviewLength {
+		return nil, fmt.Errorf("duration_minutes must be between %d and %d", minSlotMinutes, int(maxInterviewLength.Minutes()))
+	}
+	duration := time.Duration(input.DurationMinutes) * time.Minute
+	count := int(input.EndsAt.Sub(input.StartsAt) / duration)
+	if count <= 0 {
+		return nil, errors.New("the window is shorter than one slot")
+	}
+	if count > maxSlotsPerWindow {
+		return nil, fmt.Errorf("a window can hold at most %d slots", maxSlotsPerWindow)
+	}
+	videoURL, err := interviewVideoURL(input.VideoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := uc.interviewTeam(job)
+	if err != nil {
+		return nil, err
+	}
+	interviewerID := input.InterviewerID
+	if interviewerID == 0 {
+		interviewerID = subject.UserID
+	}
+	interviewer, ok := team[interviewerID]
+	if !ok {
+		return nil, fmt.Errorf("user %d is not on the hiring team of this job", interviewerID)
+	}
+
+	if input.StageID != nil {
+		stages, err := uc.pipelines.forJob(job)
+		if err != nil {
+			return nil, err
+		}
+		stage := findStage(stages, *input.StageID)
+		if stage == nil {
+			return nil, errors.New("stage does not belong to this job's pipeline")
+		}
+		if stage.IsTerminal() {
+			return nil, errors.New("slots cannot be offered to hired or rejected candidates")
+		}
+	}
+
+	endsAt := input.StartsAt.Add(time.Duration(count) * duration)
+	overlapping, err := uc.slotRepo.CountOverlapping(interviewerID, input.StartsAt, endsAt)
+	if err != nil {
+		return nil, err
+	}
+	if overlapping > 0 {
+		return nil, errors.New("the window overlaps availability already published for this interviewer")
+	}
+
+	slots := make([]domain.InterviewSlot, count)
+	for i := range slots {
+		start := input.StartsAt.Add(time.Duration(i) * duration)
+		slots[i] = domain.InterviewSlot{
+			JobID:         job.ID,
+			StageID:       input.StageID,
+			InterviewerID: interviewerID,
+			Interviewer:   interviewer,
+			StartsAt:      start,
+			EndsAt:        start.Add(duration),
+			Location:      strings.TrimSpace(input.Location),
+			VideoURL:      videoURL,
+		}
+	}
+	if err := uc.slotRepo.CreateMany(slots); err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.InterviewSlotOutputDTO, len(slots))
+	for i := range slots {
+		output[i] = toSlotOutput(&slots[i])
+	}
+	return output, nil
+}
+
+// GetJobInterviewSlots lists the upcoming slots of a job, booked or not, for
+// its hiring team.
+func (uc *ApplicationUseCase) GetJobInterviewSlots(subject authz.Subject, jobID uint) ([]dto.InterviewSlotOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionJobManageSlots, job); err != nil {
+		return nil, err
+	}
+
+	slots, err := uc.slotRepo.FindUpcomingByJobID(job.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.InterviewSlotOutputDTO, len(slots))
+	for i := range slots {
+		output[i] = toSlotOutput(&slots[i])
+	}
+	return output, nil
+}
+
+// DeleteInterviewSlot withdraws a slot nobody has booked.
+func (uc *ApplicationUseCase) DeleteInterviewSlot(subject authz.Subject, jobID, slotID uint) error {
+	job, err := uc.jobRepo.FindByID(jobID)
+	if err != nil {
+		return errors.New("job not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionJobManageSlots, job); err != nil {
+		return err
+	}
+
+	slot, err := uc.slotRepo.FindByID(slotID)
+	if err != nil || slot.JobID != job.ID {
+		return errors.New("slot not found")
+	}
+	if slot.Booked() {
+		return errors.New("slot has already been booked")
+	}
+	return uc.slotRepo.Delete(slot)
+}
+
+// GetOpenInterviewSlots lists the slots the candidate of an application may
+// book: free, far enough ahead and offered to the application's stage.
+func (uc *ApplicationUseCase) GetOpenInterviewSlots(subject authz.Subject, appID uint) ([]dto.InterviewSlotOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(appID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationBook, app); err != nil {
+		return nil, err
+	}
+
+	output := []dto.InterviewSlotOutputDTO{}
+	if app.Status != domain.StatusPending {
+		return output, nil
+	}
+
+	slots, err := uc.slotRepo.FindUpcomingByJobID(app.JobID, time.Now().Add(minBookingNotice))
+	if err != nil {
+		return nil, err
+	}
+	for i := range slots {
+		if slots[i].Booked() || !slots[i].OpenTo(app.StageID) {
+			continue
+		}
+		// The video link is sent with the invitation once booked.
+		slot := toSlotOutput(&slots[i])
+		slot.VideoURL = ""
+		output = append(output, slot)
+	}
+	return output, nil
+}
+
+// BookInterviewSlot books a slot for the candidate's own application and
+// schedules the interview with the slot's interviewer, who organizes it. Each
+// application holds at most one booked interview at a time.
+func (uc *ApplicationUseCase) BookInterviewSlot(subject authz.Subject, input dto.BookInterviewSlotInputDTO) (*dto.InterviewOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(input.ApplicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationBook, app); err != nil {
+		return nil, err
+	}
+
+	if app.Status != domain.StatusPending {
+		return nil, errors.New("interviews can only be booked for pending applications")
+	}
+
+	slot, err := uc.slotRepo.FindByID(input.SlotID)
+	if err != nil || slot.JobID != app.JobID {
+		return nil, errors.New("slot not found")
+	}
+	if !slot.OpenTo(app.StageID) {
+		return nil, errors.New("this slot is not offered at your application's stage")
+	}
+	if slot.Booked() {
+		return nil, errors.New("slot has already been booked, pick another one")
+	}
+	if slot.StartsAt.Before(time.Now().Add(minBookingNotice)) {
+		return nil, fmt.Errorf("slots must be booked at least %d hour(s) ahead", int(minBookingNotice.Hours()))
+	}
+
+	uid, err := uc.newInterviewUID()
+	if err != nil {
+		return nil, err
+	}
+
+	interview := &domain.Interview{
+		ApplicationID: app.ID,
+		Application:   *app,
+		UID:           uid,
+		StartsAt:      slot.StartsAt,
+		EndsAt:        slot.EndsAt,
+		Location:      slot.Location,
+		VideoURL:      slot.VideoURL,
+		Status:        domain.InterviewScheduled,
+		Interviewers:  []domain.InterviewParticipant{{UserID: slot.InterviewerID, User: slot.Interviewer}},
+		ScheduledByID: slot.InterviewerID,
+		ScheduledBy:   slot.Interviewer,
+	}
+	if err := uc.slotRepo.Book(slot, interview); err != nil {
+		return nil, err
+	}
+
+	uc.sendInvitation(interview, calendarRequest, interviewAttendees(interview))
+
+	output := toInterviewOutput(interview)
+	return &output, nil
+}
+
+func toSlotOutput(slot *domain.InterviewSlot) dto.InterviewSlotOutputDTO {
+	output := dto.InterviewSlotOutputDTO{
+		ID:              slot.ID,
+		JobID:           slot.JobID,
+		StageID:         slot.StageID,
+		InterviewerID:   slot.InterviewerID,
+		InterviewerName: slot.Interviewer.Name,
+		StartsAt:        slot.StartsAt.Format(time.RFC3339),
+		EndsAt:          slot.EndsAt.Format(time.RFC3339),
+		Location:        slot.Location,
+		VideoURL:        slot.VideoURL,
+		Booked:          slot.Booked(),
+		InterviewID:     slot.InterviewID,
+	}
+	if slot.Interview != nil {
+		output.CandidateName = slot.Interview.Application.Candidate.Name
+	}
+	return output
+}
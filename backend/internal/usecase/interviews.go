@@ -0,0 +1,456 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const (
+	maxInterviewLength  = 8 * time.Hour
+	interviewTimeLayout = "Mon, 02 Jan 2006 15:04 MST"
+)
+
+// GetInterviews lists the interviews of an application, soonest first, for
+// its candidate or the members of the job's organization.
+func (uc *ApplicationUseCase) GetInterviews(subject authz.Subject, appID uint) ([]dto.InterviewOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(appID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationInterviews, app); err != nil {
+		return nil, err
+	}
+
+	interviews, err := uc.interviewRepo.FindByApplicationID(app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.InterviewOutputDTO, len(interviews))
+	for i := range interviews {
+		output[i] = toInterviewOutput(&interviews[i])
+	}
+	return output, nil
+}
+
+// ScheduleInterview books an interview for a pending application and sends
+// the candidate and the interviewers an iCalendar invitation. Without
+// interviewers the subject interviews alone.
+func (uc *ApplicationUseCase) ScheduleInterview(subject authz.Subject, input dto.ScheduleInterviewInputDTO) (*dto.InterviewOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(input.ApplicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationSchedule, app); err != nil {
+		return nil, err
+	}
+
+	if app.Status != domain.StatusPending {
+		return nil, errors.New("interviews can only be scheduled for pending applications")
+	}
+	if err := checkInterviewTime(input.StartsAt, input.EndsAt, time.Now()); err != nil {
+		return nil, err
+	}
+	videoURL, err := interviewVideoURL(input.VideoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := uc.interviewTeam(&app.Job)
+	if err != nil {
+		return nil, err
+	}
+	organizer, ok := team[subject.UserID]
+	if !ok {
+		return nil, errors.New("only the hiring team can schedule interviews")
+	}
+	interviewerIDs := input.InterviewerIDs
+	if len(interviewerIDs) == 0 {
+		interviewerIDs = []uint{subject.UserID}
+	}
+	interviewers, err := interviewersOf(team, interviewerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, err := uc.newInterviewUID()
+	if err != nil {
+		return nil, err
+	}
+
+	interview := &domain.Interview{
+		ApplicationID: app.ID,
+		Application:   *app,
+		UID:           uid,
+		StartsAt:      input.StartsAt,
+		EndsAt:        input.EndsAt,
+		Location:      strings.TrimSpace(input.Location),
+		VideoURL:      videoURL,
+		Status:        domain.InterviewScheduled,
+		Interviewers:  interviewers,
+		ScheduledByID: subject.UserID,
+		ScheduledBy:   organizer,
+	}
+	if err := uc.interviewRepo.Create(interview); err != nil {
+		return nil, err
+	}
+
+	uc.sendInvitation(interview, calendarRequest, interviewAttendees(interview))
+
+	output := toInterviewOutput(interview)
+	return &output, nil
+}
+
+// RescheduleInterview moves a scheduled interview and sends an update of the
+// same calendar event. Interviewers taken off the interview get a
+// cancellation instead.
+func (uc *ApplicationUseCase) RescheduleInterview(subject authz.Subject, input dto.RescheduleInterviewInputDTO) (*dto.InterviewOutputDTO, error) {
+	interview, err := uc.findInterview(input.ApplicationID, input.InterviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationSchedule, &interview.Application); err != nil {
+		return nil, err
+	}
+
+	if !interview.Active() {
+		return nil, errors.New("only scheduled interviews can be rescheduled")
+	}
+	if err := checkInterviewTime(input.StartsAt, input.EndsAt, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if input.VideoURL != nil {
+		videoURL, err := interviewVideoURL(*input.VideoURL)
+		if err != nil {
+			return nil, err
+		}
+		interview.VideoURL = videoURL
+	}
+	if input.Location != nil {
+		interview.Location = strings.TrimSpace(*input.Location)
+	}
+
+	var removed []calendarPerson
+	if input.InterviewerIDs != nil {
+		if len(*input.InterviewerIDs) == 0 {
+			return nil, errors.New("an interview needs at least one interviewer")
+		}
+		team, err := uc.interviewTeam(&interview.Application.Job)
+		if err != nil {
+			return nil, err
+		}
+		interviewers, err := interviewersOf(team, *input.InterviewerIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, old := range interview.Interviewers {
+			if !hasInterviewer(interviewers, old.UserID) {
+				removed = append(removed, calendarPerson{Name: old.User.Name, Email: old.User.Email})
+			}
+		}
+		interview.Interviewers = interviewers
+	}
+
+	interview.StartsAt = input.StartsAt
+	interview.EndsAt = input.EndsAt
+	interview.Sequence++
+	if err := uc.interviewRepo.Update(interview); err != nil {
+		return nil, err
+	}
+
+	uc.sendInvitation(interview, calendarRequest, interviewAttendees(interview))
+	if len(removed) > 0 {
+		uc.sendInvitation(interview, calendarCancel, removed)
+	}
+
+	output := toInterviewOutput(interview)
+	return &output, nil
+}
+
+// CancelInterview cancels a scheduled interview and removes it from every
+// attendee's calendar. A slot the interview was booked on opens again.
+func (uc *ApplicationUseCase) CancelInterview(subject authz.Subject, input dto.CancelInterviewInputDTO) (*dto.InterviewOutputDTO, error) {
+	interview, err := uc.findInterview(input.ApplicationID, input.InterviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationSchedule, &interview.Application); err != nil {
+		return nil, err
+	}
+
+	if !interview.Active() {
+		return nil, errors.New("only scheduled interviews can be canceled")
+	}
+
+	interview.Status = domain.InterviewCanceled
+	interview.CancelReason = strings.TrimSpace(input.Reason)
+	interview.Sequence++
+	if err := uc.interviewRepo.Cancel(interview); err != nil {
+		return nil, err
+	}
+
+	uc.sendInvitation(interview, calendarCancel, interviewAttendees(interview))
+
+	output := toInterviewOutput(interview)
+	return &output, nil
+}
+
+// RecordInterviewOutcome marks an interview that has started as DONE or
+// NO_SHOW. Attendees are not notified.
+func (uc *ApplicationUseCase) RecordInterviewOutcome(subject authz.Subject, input dto.InterviewOutcomeInputDTO) (*dto.InterviewOutputDTO, error) {
+	interview, err := uc.findInterview(input.ApplicationID, input.InterviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationSchedule, &interview.Application); err != nil {
+		return nil, err
+	}
+
+	status := domain.InterviewStatus(input.Status)
+	if status != domain.InterviewDone && status != domain.InterviewNoShow {
+		return nil, errors.New("status must be DONE or NO_SHOW")
+	}
+	if !interview.Active() {
+		return nil, errors.New("only scheduled interviews can be marked as done or no-show")
+	}
+	if time.Now().Before(interview.StartsAt) {
+		return nil, errors.New("interviews can only be marked as done or no-show once they have started")
+	}
+
+	interview.Status = status
+	if err := uc.interviewRepo.Update(interview); err != nil {
+		return nil, err
+	}
+
+	output := toInterviewOutput(interview)
+	return &output, nil
+}
+
+// InterviewCalendar renders the current state of an interview as an .ics
+// file, so attendees can add it to their calendar by hand.
+func (uc *ApplicationUseCase) InterviewCalendar(subject authz.Subject, appID, interviewID uint) ([]byte, error) {
+	interview, err := uc.findInterview(appID, interviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationInterviews, &interview.Application); err != nil {
+		return nil, err
+	}
+
+	method := calendarRequest
+	if interview.Status == domain.InterviewCanceled {
+		method = calendarCancel
+	}
+	return encodeCalendar(interviewEvent(interview, method, interviewAttendees(interview)), time.Now()), nil
+}
+
+func (uc *ApplicationUseCase) findInterview(appID, interviewID uint) (*domain.Interview, error) {
+	interview, err := uc.interviewRepo.FindByID(interviewID)
+	if err != nil || interview.ApplicationID != appID {
+		return nil, errors.New("interview not found")
+	}
+	return interview, nil
+}
+
+// interviewTeam is the hiring team of job, including the recruiter who owns
+// it when the job belongs to no organization.
+func (uc *ApplicationUseCase) interviewTeam(job *domain.Job) (map[uint]domain.User, error) {
+	team, err := uc.hiringTeam(job)
+	if err != nil {
+		return nil, err
+	}
+	if job.RecruiterID != 0 && job.Recruiter.ID == job.RecruiterID {
+		team[job.RecruiterID] = job.Recruiter
+	}
+	return team, nil
+}
+
+// newInterviewUID returns a globally unique calendar UID under the host of
+// the application URL.
+func (uc *ApplicationUseCase) newInterviewUID() (string, error) {
+	token, err := randomToken(16)
+	if err != nil {
+		return "", err
+	}
+	host := "recruitment-system"
+	if u, err := url.Parse(uc.appURL); err == nil && u.Hostname() != "" {
+		host = u.Hostname()
+	}
+	return fmt.Sprintf("interview-%s@%s", token, host), nil
+}
+
+// sendInvitation mails the invitation to each recipient separately. Failures
+// are logged: the interview is stored either way and its .ics can be
+// downloaded again.
+func (uc *ApplicationUseCase) sendInvitation(interview *domain.Interview, method calendarMethod, recipients []calendarPerson) {
+	event := interviewEvent(interview, method, recipients)
+	ics := encodeCalendar(event, time.Now())
+	job := interview.Application.Job.Title
+
+	subject := fmt.Sprintf("Interview scheduled: %s", job)
+	body := fmt.Sprintf("An interview for %s has been scheduled.", job)
+	switch {
+	case method == calendarCancel:
+		subject = fmt.Sprintf("Interview canceled: %s", job)
+		body = fmt.Sprintf("The interview for %s on %s has been canceled.", job, interview.StartsAt.UTC().Format(interviewTimeLayout))
+		if interview.CancelReason != "" {
+			body += "\n\nReason: " + interview.CancelReason
+		}
+	case interview.Sequence > 0:
+		subject = fmt.Sprintf("Interview rescheduled: %s", job)
+		body = fmt.Sprintf("The interview for %s has been moved.", job)
+	}
+	if method == calendarRequest {
+		body += fmt.Sprintf("\n\nWhen: %s to %s", interview.StartsAt.UTC().Format(interviewTimeLayout), interview.EndsAt.UTC().Format(interviewTimeLayout))
+		if interview.Location != "" {
+			body += "\nWhere: " + interview.Location
+		}
+		if interview.VideoURL != "" {
+			body += "\nVideo call: " + interview.VideoURL
+		}
+	}
+
+	for _, r := range recipients {
+		err := uc.mailer.Send(domain.MailMessage{
+			To:      []string{r.Email},
+			Subject: subject,
+			Body:    fmt.Sprintf("Hello %s,\n\n%s\n", r.Name, body),
+			Attachments: []domain.MailAttachment{{
+				Filename:    "invite.ics",
+				ContentType: "text/calendar; charset=utf-8; method=" + string(method),
+				Data:        ics,
+			}},
+		})
+		if err != nil {
+			log.Println("Interview: failed to send invitation to", r.Email+":", err)
+		}
+	}
+}
+
+func interviewEvent(interview *domain.Interview, method calendarMethod, attendees []calendarPerson) calendarEvent {
+	app := &interview.Application
+	description := fmt.Sprintf("Interview with %s for %s.", app.Candidate.Name, app.Job.Title)
+	if interview.VideoURL != "" {
+		description += "\nVideo call: " + interview.VideoURL
+	}
+	return calendarEvent{
+		UID:         interview.UID,
+		Sequence:    interview.Sequence,
+		Method:      method,
+		Start:       interview.StartsAt,
+		End:         interview.EndsAt,
+		Summary:     "Interview: " + app.Job.Title,
+		Description: description,
+		Location:    interview.Location,
+		URL:         interview.VideoURL,
+		Organizer:   calendarPerson{Name: interview.ScheduledBy.Name, Email: interview.ScheduledBy.Email},
+		Attendees:   attendees,
+	}
+}
+
+// interviewAttendees are the candidate followed by the interviewers.
+func interviewAttendees(interview *domain.Interview) []calendarPerson {
+	candidate := interview.Application.Candidate
+	attendees := []calendarPerson{{Name: candidate.Name, Email: candidate.Email}}
+	for _, i := range interview.Interviewers {
+		attendees = append(attendees, calendarPerson{Name: i.User.Name, Email: i.User.Email})
+	}
+	return attendees
+}
+
+func checkInterviewTime(startsAt, endsAt, now time.Time) error {
+	if startsAt.IsZero() || endsAt.IsZero() {
+		return errors.New("starts_at and ends_at are required")
+	}
+	if !startsAt.After(now) {
+		return errors.New("interviews must start in the future")
+	}
+	if !endsAt.After(startsAt) {
+		return errors.New("ends_at must be after starts_at")
+	}
+	if endsAt.Sub(startsAt) > maxInterviewLength {
+		return fmt.Errorf("interviews can last at most %d hours", int(maxInterviewLength.Hours()))
+	}
+	return nil
+}
+
+func interviewVideoURL(raw string) (string, error) {
+	link := strings.TrimSpace(raw)
+	if link == "" {
+		return "", nil
+	}
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", errors.New("video_url must be an http or https URL")
+	}
+	return link, nil
+}
+
+// interviewersOf checks that every interviewer is on the hiring team,
+// ignoring repeated IDs.
+func interviewersOf(team map[uint]domain.User, userIDs []uint) ([]domain.InterviewParticipant, error) {
+	var interviewers []domain.InterviewParticipant
+	for _, id := range userIDs {
+		if hasInterviewer(interviewers, id) {
+			continue
+		}
+		user, ok := team[id]
+		if !ok {
+			return nil, fmt.Errorf("user %d is not on the hiring team of this job", id)
+		}
+		interviewers = append(interviewers, domain.InterviewParticipant{UserID: id, User: user})
+	}
+	return interviewers, nil
+}
+
+func hasInterviewer(interviewers []domain.InterviewParticipant, userID uint) bool {
+	for _, i := range interviewers {
+		if i.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func toInterviewOutput(interview *domain.Interview) dto.InterviewOutputDTO {
+	app := &interview.Application
+	output := dto.InterviewOutputDTO{
+		ID:              interview.ID,
+		ApplicationID:   interview.ApplicationID,
+		JobID:           app.JobID,
+		JobTitle:        app.Job.Title,
+		CandidateID:     app.CandidateID,
+		CandidateName:   app.Candidate.Name,
+		UID:             interview.UID,
+		Sequence:        interview.Sequence,
+		StartsAt:        interview.StartsAt.Format(time.RFC3339),
+		EndsAt:          interview.EndsAt.Format(time.RFC3339),
+		Location:        interview.Location,
+		VideoURL:        interview.VideoURL,
+		Status:          string(interview.Status),
+		Interviewers:    make([]dto.InterviewerOutputDTO, len(interview.Interviewers)),
+		ScheduledByID:   interview.ScheduledByID,
+		ScheduledByName: interview.ScheduledBy.Name,
+		CancelReason:    interview.CancelReason,
+		CreatedAt:       interview.CreatedAt.Format(time.RFC3339),
+	}
+	for i, p := range interview.Interviewers {
+		output.Interviewers[i] = dto.InterviewerOutputDTO{UserID: p.UserID, Name: p.User.Name}
+	}
+	return output
+}
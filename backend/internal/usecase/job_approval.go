@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+// SubmitJob asks the approvers of the job's organization to publish a complete
+// DRAFT job, or to reopen an EXPIRED one. The job waits in PENDING_APPROVAL
+// until one of them decides.
+func (uc *JobUseCase) SubmitJob(subject authz.Subject, jobID uint, comment string) (*dto.JobApprovalOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobSubmit, job); err != nil {
+		return nil, err
+	}
+
+	approval, err := uc.submitJob(job, subject.UserID, comment, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	output := toJobApprovalOutput(approval)
+	return &output, nil
+}
+
+func (uc *JobUseCase) submitJob(job *domain.Job, requesterID uint, comment string, now time.Time) (*domain.JobApproval, error) {
+	if job.Status != domain.JobStatusDraft && job.Status != domain.JobStatusExpired {
+		return nil, errors.New("only DRAFT or EXPIRED jobs can be submitted for approval")
+	}
+	if !job.RequiresApproval() {
+		return nil, errors.New("this organization does not require approval, publish the job instead")
+	}
+	return uc.requestApproval(job, requesterID, comment, now)
+}
+
+// requestApproval moves a job that is ready to go live to PENDING_APPROVAL
+// with a new approval request.
+func (uc *JobUseCase) requestApproval(job *domain.Job, requesterID uint, comment string, now time.Time) (*domain.JobApproval, error) {
+	approval, err := newApproval(job, requesterID, comment, now)
+	if err != nil {
+		return nil, err
+	}
+	from := job.Status
+	job.Status = domain.JobStatusPendingApproval
+	if err := uc.approvalRepo.CreateWithJob(approval, job, from); err != nil {
+		job.Status = from
+		return nil, err
+	}
+	approval.Job = *job
+	return approval, nil
+}
+
+// newApproval builds the approval request of a job that is ready to go live.
+func newApproval(job *domain.Job, requesterID uint, comment string, now time.Time) (*domain.JobApproval, error) {
+	if err := checkPublishable(job, now); err != nil {
+		return nil, err
+	}
+	return &domain.JobApproval{
+		JobID:          job.ID,
+		OrganizationID: *job.OrganizationID,
+		RequestedByID:  requesterID,
+		Comment:        strings.TrimSpace(comment),
+		Status:         domain.ApprovalPending,
+	}, nil
+}
+
+// DecideJob approves or rejects the pending approval request of a job.
+// Approving publishes the job, or schedules it when its PublishAt is still
+// ahead; rejecting sends it back to DRAFT and needs a comment telling the
+// recruiters what to change.
+func (uc *JobUseCase) DecideJob(subject authz.Subject, input dto.DecideJobInputDTO) (*dto.JobApprovalOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(input.JobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	approval, err := uc.approvalRepo.FindPendingByJobID(job.ID)
+	if err != nil {
+		return nil, errors.New("job has no pending approval request")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobApprove, approval); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	comment := strings.TrimSpace(input.Comment)
+	if input.Approve {
+		if err := checkPublishable(job, now); err != nil {
+			return nil, fmt.Errorf("job cannot be approved: %w", err)
+		}
+		approval.Status = domain.ApprovalApproved
+		openJob(job, now)
+	} else {
+		if comment == "" {
+			return nil, errors.New("a comment is required to reject a job")
+		}
+		approval.Status = domain.ApprovalRejected
+		job.Status = domain.JobStatusDraft
+	}
+	approval.DecidedByID = &subject.UserID
+	approval.DecisionComment = comment
+	approval.DecidedAt = &now
+
+	if err := uc.approvalRepo.UpdateWithJob(approval, job); err != nil {
+		return nil, err
+	}
+
+	approval.Job = *job
+	output := toJobApprovalOutput(approval)
+	return &output, nil
+}
+
+// GetJobApprovals lists every approval request of a job, newest first.
+func (uc *JobUseCase) GetJobApprovals(subject authz.Subject, jobID uint) ([]dto.JobApprovalOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobViewApprovals, job); err != nil {
+		return nil, err
+	}
+
+	approvals, err := uc.approvalRepo.FindByJobID(job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.JobApprovalOutputDTO, len(approvals))
+	for i := range approvals {
+		output[i] = toJobApprovalOutput(&approvals[i])
+	}
+	return output, nil
+}
+
+// GetApprovalQueue lists the approval requests of the organizations the
+// subject approves jobs for, filtered by input.Status when set.
+func (uc *JobUseCase) GetApprovalQueue(subject authz.Subject, input dto.PaginationInputDTO) (*dto.PaginatedJobApprovalsOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionJobListApprovals, nil); err != nil {
+		return nil, err
+	}
+
+	page := input.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := input.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+
+	memberships, err := uc.orgRepo.FindByUserID(subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var orgIDs []uint
+	for _, m := range memberships {
+		if m.Role.CanApproveJobs() {
+			orgIDs = append(orgIDs, m.OrganizationID)
+		}
+	}
+
+	output := []dto.JobApprovalOutputDTO{}
+	var total int64
+	if len(orgIDs) > 0 {
+		approvals, count, err := uc.approvalRepo.FindByOrganizationIDs(orgIDs, domain.ApprovalStatus(input.Status), page, limit)
+		if err != nil {
+			return nil, err
+		}
+		for i := range approvals {
+			output = append(output, toJobApprovalOutput(&approvals[i]))
+		}
+		total = count
+	}
+
+	totalPages := int((total + int64(limit) - 1) / int64(limit))
+
+	return &dto.PaginatedJobApprovalsOutputDTO{
+		Data: output,
+		Meta: dto.MetaDTO{
+			Total:      total,
+			Page:       page,
+			Limit:      limit,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
+
+func toJobApprovalOutput(approval *domain.JobApproval) dto.JobApprovalOutputDTO {
+	output := dto.JobApprovalOutputDTO{
+		ID:              approval.ID,
+		JobID:           approval.JobID,
+		JobTitle:        approval.Job.Title,
+		OrganizationID:  approval.OrganizationID,
+		Status:          string(approval.Status),
+		RequestedByID:   approval.RequestedByID,
+		RequestedByName: approval.RequestedBy.Name,
+		Comment:         approval.Comment,
+		DecidedByID:     approval.DecidedByID,
+		DecisionComment: approval.DecisionComment,
+		DecidedAt:       formatOptionalTime(approval.DecidedAt),
+		CreatedAt:       approval.CreatedAt.Format(time.RFC3339),
+	}
+	if approval.DecidedBy != nil {
+		output.DecidedByName = approval.DecidedBy.Name
+	}
+	return output
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+// HireCandidate hires one candidate without closing the job, as long as it
+// has openings left. The hire that fills the last opening closes the job and
+// rejects the candidates still in the process.
+func (uc *JobUseCase) HireCandidate(subject authz.Subject, input dto.HireCandidateInputDTO) (*dto.HireCandidateOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(input.JobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobHire, job); err != nil {
+		return nil, err
+	}
+	if !job.CanBecome(domain.JobStatusClosed) {
+		return nil, errors.New("only OPEN or PAUSED jobs can hire")
+	}
+
+	apps, err := uc.appRepo.FindByJobID(job.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOpenings(job, apps); err != nil {
+		return nil, err
+	}
+	app := pendingApplicationOf(apps, input.CandidateID)
+	if app == nil {
+		return nil, errors.New("candidate application not found for this job")
+	}
+
+	result, err := uc.hireFor(subject, job, app, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	output := toHireOutput(job.ID, result)
+	return &output, nil
+}
+
+// hireFor hires app, a pending application of job. The job is closed when
+// all its openings are filled, or always when finalize is set. With a nil app
+// the job is only closed if it is already filled.
+func (uc *JobUseCase) hireFor(subject authz.Subject, job *domain.Job, app *domain.Application, offer *domain.Offer, finalize bool) (*domain.HiringResult, error) {
+	stages, err := uc.pipelines.forJob(job)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := uc.jobRepo.Hire(&domain.Hiring{
+		JobID:         job.ID,
+		Application:   app,
+		Offer:         offer,
+		ActorID:       subject.UserID,
+		HiredStage:    stageOfKind(stages, domain.StageKindHired),
+		RejectedStage: stageOfKind(stages, domain.StageKindRejected),
+		RejectReason:  positionFilledReason,
+		Finalize:      finalize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	job.Status = result.Status
+	return result, nil
+}
+
+func checkOpenings(job *domain.Job, apps []domain.Application) error {
+	if countHired(apps) >= job.Openings {
+		return fmt.Errorf("all %d openings are already filled, raise openings to hire more", job.Openings)
+	}
+	return nil
+}
+
+// setOpenings changes how many candidates the job hires. Openings cannot go
+// below the hires already made. It reports whether the job is now filled and
+// should be closed.
+func (uc *JobUseCase) setOpenings(job *domain.Job, openings int) (bool, error) {
+	if openings < 1 {
+		return false, errors.New("openings must be at least 1")
+	}
+	if openings == job.Openings {
+		return false, nil
+	}
+
+	apps, err := uc.appRepo.FindByJobID(job.ID)
+	if err != nil {
+		return false, err
+	}
+	hired := countHired(apps)
+	if openings < hired {
+		return false, fmt.Errorf("the job already hired %d candidates, openings cannot be lower", hired)
+	}
+	job.Openings = openings
+
+	return openings == hired && job.CanBecome(domain.JobStatusClosed), nil
+}
+
+func toHireOutput(jobID uint, result *domain.HiringResult) dto.HireCandidateOutputDTO {
+	return dto.HireCandidateOutputDTO{
+		JobID:     jobID,
+		Status:    result.Status,
+		Openings:  result.Openings,
+		Hired:     result.Hired,
+		Remaining: result.Openings - result.Hired,
+		Closed:    result.Closed,
+	}
+}
+
+func pendingApplicationOf(apps []domain.Application, candidateID uint) *domain.Application {
+	for i := range apps {
+		if apps[i].CandidateID == candidateID && apps[i].Status == domain.StatusPending {
+			return &apps[i]
+		}
+	}
+	return nil
+}
+
+func countHired(apps []domain.Application) int {
+	hired := 0
+	for _, a := range apps {
+		if a.Status == domain.StatusHired {
+			hired++
+		}
+	}
+	return hired
+}
@@ -0,0 +1,349 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+// applySchedule validates and sets when the job is published and when it
+// expires. nil values leave the current ones untouched. The publication time
+// can only move while the job is a DRAFT or still SCHEDULED; moving a
+// SCHEDULED job's time into the past publishes it right away.
+func applySchedule(job *domain.Job, publishAt, expiresAt *time.Time, now time.Time) error {
+	if publishAt != nil {
+		switch job.Status {
+		case domain.JobStatusDraft:
+		case domain.JobStatusScheduled:
+			job.Status = domain.JobStatusOpen
+			if publishAt.After(now) {
+				job.Status = domain.JobStatusScheduled
+			}
+		default:
+			return errors.New("publish_at can only be changed before the job is published")
+		}
+		job.PublishAt = publishAt
+	}
+	if expiresAt != nil {
+		if !expiresAt.After(now) {
+			return errors.New("expires_at must be in the future")
+		}
+		job.ExpiresAt = expiresAt
+	}
+	if job.PublishAt != nil && job.ExpiresAt != nil && !job.ExpiresAt.After(*job.PublishAt) {
+		return errors.New("expires_at must be after publish_at")
+	}
+	return nil
+}
+
+// publishJob moves a DRAFT job to OPEN, or to SCHEDULED when its PublishAt is
+// still ahead, once it has everything candidates need to see. Jobs of
+// organizations that require approval are submitted instead, see SubmitJob.
+func publishJob(job *domain.Job, now time.Time) error {
+	if job.Status != domain.JobStatusDraft {
+		return errors.New("only DRAFT jobs can be published")
+	}
+	if job.RequiresApproval() {
+		return errors.New("this organization requires jobs to be approved, submit the job for approval instead")
+	}
+	if err := checkPublishable(job, now); err != nil {
+		return err
+	}
+	openJob(job, now)
+	return nil
+}
+
+// checkPublishable reports what keeps the job from going live at now.
+func checkPublishable(job *domain.Job, now time.Time) error {
+	if missing := job.MissingFields(); len(missing) > 0 {
+		return fmt.Errorf("job is incomplete, missing: %s", strings.Join(missing, ", "))
+	}
+	if job.ExpiresAt != nil && !job.ExpiresAt.After(now) {
+		return errors.New("expires_at must be in the future")
+	}
+	return nil
+}
+
+// openJob makes the job public, or SCHEDULED when its PublishAt is still
+// ahead. Going live takes the job out of the archive.
+func openJob(job *domain.Job, now time.Time) {
+	job.ArchivedAt = nil
+	job.Status = domain.JobStatusOpen
+	if job.PublishAt != nil && job.PublishAt.After(now) {
+		job.Status = domain.JobStatusScheduled
+	}
+}
+
+// changeJobStatus applies a status change requested by the job's recruiters,
+// following the job state machine. Opening a DRAFT publishes it, opening a
+// SCHEDULED job publishes it now, and an EXPIRED job only reopens once its
+// expiration was moved to the future, through SubmitJob when its organization
+// requires approval. CLOSED jobs go through ReopenJob.
+func changeJobStatus(job *domain.Job, status string, now time.Time) error {
+	switch status {
+	case domain.JobStatusDraft, domain.JobStatusOpen, domain.JobStatusPaused:
+	case domain.JobStatusClosed:
+		return errors.New("use finalize endpoint to close a job")
+	case domain.JobStatusScheduled, domain.JobStatusExpired:
+		return errors.New("set publish_at or expires_at to schedule a job")
+	case domain.JobStatusPendingApproval:
+		return errors.New("use submit endpoint to request approval")
+	default:
+		return errors.New("invalid status")
+	}
+	if status == job.Status {
+		return nil
+	}
+	if !job.CanBecome(status) {
+		return fmt.Errorf("a %s job cannot be moved to %s", job.Status, status)
+	}
+
+	switch job.Status {
+	case domain.JobStatusDraft:
+		return publishJob(job, now)
+	case domain.JobStatusScheduled:
+		job.PublishAt = &now
+	case domain.JobStatusExpired:
+		if job.ExpiresAt != nil && !job.ExpiresAt.After(now) {
+			return errors.New("set a future expires_at to reopen an expired job")
+		}
+		if job.RequiresApproval() {
+			return errors.New("this organization requires jobs to be approved, submit the job for approval to reopen it")
+		}
+		job.ArchivedAt = nil
+	}
+	job.Status = status
+	return nil
+}
+
+// RunSchedule expires the jobs past their ExpiresAt, opens the scheduled jobs
+// whose PublishAt has come and expires the offers left unanswered. It is run
+// periodically by the API process.
+func (uc *JobUseCase) RunSchedule(now time.Time) error {
+	expired, err := uc.jobRepo.ExpireDue(now)
+	if err != nil {
+		return err
+	}
+	published, err := uc.jobRepo.PublishDue(now)
+	if err != nil {
+		return err
+	}
+	if expired > 0 || published > 0 {
+		log.Printf("Job schedule: published %d jobs, expired %d jobs", published, expired)
+	}
+	offers, err := uc.offerRepo.ExpireDue(now)
+	if err != nil {
+		return err
+	}
+	if offers > 0 {
+		log.Printf("Job schedule: expired %d offers", offers)
+	}
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
+
+// positionFilledReason is why FinalizeJob rejects the candidates it did not
+// hire; ReopenJob recognizes those rejections by it.
+const positionFilledReason = "position filled"
+
+// ReopenJob opens a CLOSED job again, or submits it for approval when its
+// organization requires jobs to be approved. The hired candidate stays hired.
+// With input.RestoreRejected, candidates rejected only because the job was
+// closed go back to PENDING in the stage they were in; candidates rejected by
+// a recruiter stay rejected either way.
+func (uc *JobUseCase) ReopenJob(subject authz.Subject, input dto.ReopenJobInputDTO) (*dto.ReopenJobOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(input.JobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobReopen, job); err != nil {
+		return nil, err
+	}
+	if job.Status != domain.JobStatusClosed {
+		return nil, errors.New("only CLOSED jobs can be reopened")
+	}
+
+	now := time.Now()
+	if err := applySchedule(job, nil, input.ExpiresAt, now); err != nil {
+		return nil, err
+	}
+	if job.ExpiresAt != nil && !job.ExpiresAt.After(now) {
+		return nil, errors.New("set a future expires_at to reopen the job")
+	}
+
+	reopening := &domain.JobReopening{Job: job}
+	if job.RequiresApproval() {
+		if reopening.Approval, err = newApproval(job, subject.UserID, "", now); err != nil {
+			return nil, err
+		}
+	}
+	if input.RestoreRejected {
+		if reopening.Restorations, err = uc.restorations(subject, job, now); err != nil {
+			return nil, err
+		}
+	}
+
+	job.ArchivedAt = nil
+	job.Status = domain.JobStatusOpen
+	if reopening.Approval != nil {
+		job.Status = domain.JobStatusPendingApproval
+	}
+	if err := uc.jobRepo.Reopen(reopening); err != nil {
+		return nil, err
+	}
+
+	return &dto.ReopenJobOutputDTO{
+		GetJobOutputDTO:      toRecruiterJobOutput(job),
+		RestoredApplications: len(reopening.Restorations),
+	}, nil
+}
+
+// restorations moves the applications FinalizeJob rejected back to PENDING,
+// in the stage named by their rejection event, or the first stage when the
+// pipeline no longer has it. The changes are stored by JobRepository.Reopen.
+func (uc *JobUseCase) restorations(subject authz.Subject, job *domain.Job, now time.Time) ([]domain.ApplicationTransition, error) {
+	apps, err := uc.appRepo.FindByJobID(job.ID)
+	if err != nil {
+		return nil, err
+	}
+	stages, err := uc.pipelines.forJob(job)
+	if err != nil {
+		return nil, err
+	}
+
+	var restorations []domain.ApplicationTransition
+	for i := range apps {
+		if apps[i].Status != domain.StatusRejected {
+			continue
+		}
+		events, err := uc.appRepo.FindEvents(apps[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			continue
+		}
+		last := events[len(events)-1]
+		if last.Type != domain.EventRejected || last.Reason != positionFilledReason {
+			continue
+		}
+
+		stage := activeStageNamed(stages, last.FromStage)
+		if stage == nil {
+			continue
+		}
+		fromStatus, fromStage := apps[i].Status, apps[i].Stage
+		apps[i].Status = domain.StatusPending
+		apps[i].StageID = &stage.ID
+		apps[i].Stage = stage
+		apps[i].StageChangedAt = &now
+		apps[i].StageChangedByID = &subject.UserID
+
+		event := newApplicationEvent(&apps[i], domain.EventRestored, &subject.UserID, fromStatus, fromStage, "job reopened")
+		restorations = append(restorations, domain.ApplicationTransition{Application: &apps[i], Event: event})
+	}
+	return restorations, nil
+}
+
+// activeStageNamed finds the active stage called name, falling back to the
+// first active stage.
+func activeStageNamed(stages []domain.PipelineStage, name string) *domain.PipelineStage {
+	var first *domain.PipelineStage
+	for i := range stages {
+		if stages[i].IsTerminal() {
+			continue
+		}
+		if stages[i].Name == name {
+			return &stages[i]
+		}
+		if first == nil {
+			first = &stages[i]
+		}
+	}
+	return first
+}
+
+// ArchiveJob hides a job that is not live from the recruiters' job list, or
+// brings it back when archived is false. Publishing or reopening an archived
+// job also unarchives it.
+func (uc *JobUseCase) ArchiveJob(subject authz.Subject, id uint, archived bool) (*dto.GetJobOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobArchive, job); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case archived && job.ArchivedAt == nil:
+		if !job.Archivable() {
+			return nil, errors.New("only DRAFT, CLOSED or EXPIRED jobs can be archived")
+		}
+		now := time.Now()
+		job.ArchivedAt = &now
+	case !archived:
+		job.ArchivedAt = nil
+	}
+	if err := uc.jobRepo.Update(job); err != nil {
+		return nil, err
+	}
+
+	output := toRecruiterJobOutput(job)
+	return &output, nil
+}
+
+// DeleteJob soft-deletes a job; RestoreJob brings it back. OPEN jobs must be
+// paused or closed first and jobs awaiting approval must be decided.
+func (uc *JobUseCase) DeleteJob(subject authz.Subject, id uint) error {
+	job, err := uc.jobRepo.FindByID(id)
+	if err != nil {
+		return errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobDelete, job); err != nil {
+		return err
+	}
+
+	switch job.Status {
+	case domain.JobStatusOpen:
+		return errors.New("pause or close the job before deleting it")
+	case domain.JobStatusPendingApproval:
+		return errors.New("jobs awaiting approval cannot be deleted")
+	}
+
+	return uc.jobRepo.Delete(job)
+}
+
+func (uc *JobUseCase) RestoreJob(subject authz.Subject, id uint) (*dto.GetJobOutputDTO, error) {
+	job, err := uc.jobRepo.FindDeletedByID(id)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobDelete, job); err != nil {
+		return nil, err
+	}
+
+	if err := uc.jobRepo.Restore(job); err != nil {
+		return nil, err
+	}
+
+	job, err = uc.jobRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	output := toRecruiterJobOutput(job)
+	return &output, nil
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+// jobFilter is toJobFilter with a place name in input.Near geocoded to the
+// search point.
+func (uc *JobUseCase) jobFilter(input dto.SearchJobsInputDTO) (domain.JobFilter, error) {
+	if near := strings.TrimSpace(input.Near); near != "" && input.Lat == nil && input.Lng == nil {
+		point, ok, err := uc.geocoder.Geocode(near)
+		if err != nil {
+			return domain.JobFilter{}, err
+		}
+		if !ok {
+			return domain.JobFilter{}, fmt.Errorf("unknown location %q", near)
+		}
+		input.Lat, input.Lng = &point.Lat, &point.Lng
+	}
+	return toJobFilter(input, time.Now())
+}
+
+// toJobFilter validates the public search parameters. Salary filters are
+// scoped to one currency and period, BRL and MONTH unless given. PostedWithin
+// is turned into an absolute bound relative to now; Near must already be
+// geocoded into Lat and Lng.
+func toJobFilter(input dto.SearchJobsInputDTO, now time.Time) (domain.JobFilter, error) {
+	filter := domain.JobFilter{
+		Query:        input.Query,
+		Status:       input.Status,
+		SalaryMin:    input.SalaryMin,
+		SalaryMax:    input.SalaryMax,
+		Currency:     input.Currency,
+		SalaryPeriod: domain.SalaryPeriod(input.SalaryPeriod),
+		Categories:   cleanValues(input.Categories),
+		Locations:    cleanValues(input.Locations),
+		Companies:    cleanValues(input.Companies),
+	}
+	if filter.SalaryMin != nil && filter.SalaryMax != nil && *filter.SalaryMin > *filter.SalaryMax {
+		return filter, errors.New("salary_min cannot be greater than salary_max")
+	}
+
+	if filter.Currency != "" && !domain.IsCurrencyCode(filter.Currency) {
+		return filter, fmt.Errorf("invalid currency %q", filter.Currency)
+	}
+	if filter.SalaryPeriod != "" && !filter.SalaryPeriod.IsValid() {
+		return filter, fmt.Errorf("invalid salary_period %q", input.SalaryPeriod)
+	}
+
+	if input.SalaryBand != "" {
+		if _, ok := domain.FindSalaryBand(input.SalaryBand); !ok {
+			return filter, fmt.Errorf("invalid salary_band %q", input.SalaryBand)
+		}
+		filter.SalaryBand = input.SalaryBand
+	}
+	if filter.SalaryMin != nil || filter.SalaryMax != nil || filter.SalaryBand != "" {
+		filter = filter.ScopeSalary()
+	}
+	if input.PostedWithin != "" {
+		p, ok := domain.FindPostedWithin(input.PostedWithin)
+		if !ok {
+			return filter, fmt.Errorf("invalid posted_within %q", input.PostedWithin)
+		}
+		after := now.Add(-p.Window)
+		filter.PostedAfter = &after
+	}
+
+	if (input.Lat == nil) != (input.Lng == nil) {
+		return filter, errors.New("lat and lng must be given together")
+	}
+	if input.Lat != nil {
+		if *input.Lat < -90 || *input.Lat > 90 {
+			return filter, errors.New("lat must be between -90 and 90")
+		}
+		if *input.Lng < -180 || *input.Lng > 180 {
+			return filter, errors.New("lng must be between -180 and 180")
+		}
+		filter.Near = &domain.GeoPoint{Lat: *input.Lat, Lng: *input.Lng}
+	}
+	if input.RadiusKm != nil {
+		if filter.Near == nil {
+			return filter, errors.New("radius_km needs lat and lng or near")
+		}
+		if *input.RadiusKm <= 0 {
+			return filter, errors.New("radius_km must be positive")
+		}
+		filter.RadiusKm = input.RadiusKm
+	}
+
+	var err error
+	if filter.EmploymentTypes, err = parseEnums("employment_type", input.EmploymentTypes, domain.EmploymentType.IsValid); err != nil {
+		return filter, err
+	}
+	if filter.Seniorities, err = parseEnums("seniority", input.Seniorities, domain.Seniority.IsValid); err != nil {
+		return filter, err
+	}
+	if filter.WorkModels, err = parseEnums("work_model", input.WorkModels, domain.WorkModel.IsValid); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+func parseEnums[T ~string](param string, values []string, valid func(T) bool) ([]T, error) {
+	var out []T
+	for _, v := range cleanValues(values) {
+		value := T(strings.ToUpper(v))
+		if !valid(value) {
+			return nil, fmt.Errorf("invalid %s %q", param, v)
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+func cleanValues(values []string) []string {
+	var out []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func toFacetsOutput(facets *domain.JobFacets) *dto.JobFacetsDTO {
+	if facets == nil {
+		return nil
+	}
+	counts := func(in []domain.FacetCount) []dto.FacetCountDTO {
+		out := make([]dto.FacetCountDTO, len(in))
+		for i, c := range in {
+			out[i] = dto.FacetCountDTO{Value: c.Value, Count: c.Count}
+		}
+		return out
+	}
+	return &dto.JobFacetsDTO{
+		Location:       counts(facets.Location),
+		Company:        counts(facets.Company),
+		EmploymentType: counts(facets.EmploymentType),
+		Seniority:      counts(facets.Seniority),
+		WorkModel:      counts(facets.WorkModel),
+		SalaryBand:     counts(facets.SalaryBand),
+		PostedWithin:   counts(facets.PostedWithin),
+	}
+}
@@ -2,40 +2,131 @@ package usecase
 
 import (
 	"errors"
+	"log"
+	"math"
+	"strings"
 	"time"
 
+	"github.com/helberthlucas14/internal/authz"
 	"github.com/helberthlucas14/internal/dto"
 
 	"github.com/helberthlucas14/internal/domain"
 )
 
 type JobUseCase struct {
-	jobRepo domain.JobRepository
-	appRepo domain.ApplicationRepository
+	jobRepo      domain.JobRepository
+	appRepo      domain.ApplicationRepository
+	orgRepo      domain.OrganizationRepository
+	categoryRepo domain.CategoryRepository
+	approvalRepo domain.JobApprovalRepository
+	offerRepo    domain.OfferRepository
+	geocoder     domain.Geocoder
+	mailer       domain.MailSender
+	pipelines    pipelines
+	policy       *authz.Policy
+	appURL       string
 }
 
-func NewJobUseCase(jobRepo domain.JobRepository, appRepo domain.ApplicationRepository) *JobUseCase {
+func NewJobUseCase(jobRepo domain.JobRepository, appRepo domain.ApplicationRepository, orgRepo domain.OrganizationRepository, pipelineRepo domain.PipelineRepository, categoryRepo domain.CategoryRepository, approvalRepo domain.JobApprovalRepository, offerRepo domain.OfferRepository, geocoder domain.Geocoder, mailer domain.MailSender, policy *authz.Policy, appURL string) *JobUseCase {
 	return &JobUseCase{
-		jobRepo: jobRepo,
+		jobRepo:      jobRepo,
+		appRepo:      appRepo,
+		orgRepo:      orgRepo,
+		categoryRepo: categoryRepo,
+		approvalRepo: approvalRepo,
+		offerRepo:    offerRepo,
+		geocoder:     geocoder,
+		mailer:       mailer,
+		pipelines:    pipelines{repo: pipelineRepo},
+		policy:       policy,
+		appURL:       appURL,
 	}
 }
 
-func (uc *JobUseCase) CreateJob(input dto.CreateJobInputDTO) (*dto.CreateJobOutputDTO, error) {
+func (uc *JobUseCase) CreateJob(subject authz.Subject, input dto.CreateJobInputDTO) (*dto.CreateJobOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionJobCreate, nil); err != nil {
+		return nil, err
+	}
+
+	org, err := uc.resolveOrganization(subject.UserID, input.OrganizationID, input.Company)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionJobCreate, org); err != nil {
+		return nil, err
+	}
+
+	company := input.Company
+	if company == "" {
+		company = org.Name
+	}
+
+	questions, err := buildQuestions(input.Questions)
+	if err != nil {
+		return nil, err
+	}
+	criteriaNames := input.Criteria
+	if len(criteriaNames) == 0 {
+		criteriaNames = domain.DefaultScorecardCriteria
+	}
+	criteria, err := buildCriteria(criteriaNames, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	job := &domain.Job{
-		Title:        input.Title,
-		Description:  input.Description,
-		Company:      input.Company,
-		Location:     input.Location,
-		Requirements: input.Requirements,
-		Salary:       input.Salary,
-		Status:       "OPEN",
-		RecruiterID:  input.RecruiterID,
-		Anonymous:    input.Anonymous,
-	}
-	err := uc.jobRepo.Create(job)
+		Title:          input.Title,
+		Description:    input.Description,
+		Company:        company,
+		Location:       input.Location,
+		Requirements:   input.Requirements,
+		Status:         domain.JobStatusDraft,
+		Openings:       1,
+		RecruiterID:    subject.UserID,
+		OrganizationID: &org.ID,
+		Organization:   org,
+		Anonymous:      input.Anonymous,
+		Questions:      questions,
+		Criteria:       criteria,
+	}
+	if input.Openings < 0 {
+		return nil, errors.New("openings must be at least 1")
+	}
+	if input.Openings > 0 {
+		job.Openings = input.Openings
+	}
+	if err := uc.applyTaxonomy(job, input.CategoryID, input.EmploymentType, input.Seniority, input.WorkModel); err != nil {
+		return nil, err
+	}
+	if err := applySalary(job, input.Salary); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := applySchedule(job, input.PublishAt, input.ExpiresAt, now); err != nil {
+		return nil, err
+	}
+	// Jobs that need approval are saved as drafts and submitted right after.
+	submit := !input.Draft && job.RequiresApproval()
+	if submit {
+		if err := checkPublishable(job, now); err != nil {
+			return nil, err
+		}
+	} else if !input.Draft {
+		if err := publishJob(job, now); err != nil {
+			return nil, err
+		}
+	}
+	uc.geocode(job)
+	err = uc.jobRepo.Create(job)
 	if err != nil {
 		return nil, err
 	}
+	if submit {
+		if _, err := uc.submitJob(job, subject.UserID, "", now); err != nil {
+			return nil, err
+		}
+	}
 	var recruiterEmail *string
 	if !job.Anonymous {
 		recruiterEmail = nil
@@ -46,15 +137,62 @@ func (uc *JobUseCase) CreateJob(input dto.CreateJobInputDTO) (*dto.CreateJobOutp
 		Description:    job.Description,
 		Company:        job.Company,
 		Location:       job.Location,
+		Latitude:       job.Latitude,
+		Longitude:      job.Longitude,
+		Category:       toCategoryOutput(job.Category),
+		EmploymentType: string(job.EmploymentType),
+		Seniority:      string(job.Seniority),
+		WorkModel:      string(job.WorkModel),
+		Salary:         toSalaryOutput(job),
 		Status:         job.Status,
+		Openings:       job.Openings,
+		PublishAt:      formatOptionalTime(job.PublishAt),
+		ExpiresAt:      formatOptionalTime(job.ExpiresAt),
 		CreatedAt:      job.CreatedAt.Format(time.RFC3339),
 		RecruiterID:    job.RecruiterID,
+		OrganizationID: org.ID,
 		RecruiterEmail: recruiterEmail,
 		Anonymous:      job.Anonymous,
+		Questions:      toQuestionOutputs(job.Questions, true),
+		Criteria:       toCriterionOutputs(job.Criteria),
 	}, nil
 }
 
-func (uc *JobUseCase) GetAllJobs(input dto.PaginationInputDTO) (*dto.PaginatedJobsOutputDTO, error) {
+// resolveOrganization picks the organization a new job is posted under: the
+// explicit one, else the recruiter's only organization, else a new organization
+// named after the job's company.
+func (uc *JobUseCase) resolveOrganization(recruiterID, organizationID uint, company string) (*domain.Organization, error) {
+	if organizationID != 0 {
+		org, err := uc.orgRepo.FindByID(organizationID)
+		if err != nil {
+			return nil, errors.New("organization not found")
+		}
+		return org, nil
+	}
+
+	memberships, err := uc.orgRepo.FindByUserID(recruiterID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(memberships) {
+	case 0:
+		if company == "" {
+			return nil, errors.New("company is required to create your organization")
+		}
+		org := &domain.Organization{Name: company}
+		if err := uc.orgRepo.CreateWithOwner(org, recruiterID); err != nil {
+			return nil, err
+		}
+		return org, nil
+	case 1:
+		return &memberships[0].Organization, nil
+	default:
+		return nil, errors.New("organization_id is required when you belong to multiple organizations")
+	}
+}
+
+func (uc *JobUseCase) GetAllJobs(input dto.SearchJobsInputDTO) (*dto.PaginatedJobsOutputDTO, error) {
 	page := input.Page
 	if page <= 0 {
 		page = 1
@@ -64,33 +202,26 @@ func (uc *JobUseCase) GetAllJobs(input dto.PaginationInputDTO) (*dto.PaginatedJo
 		limit = 10
 	}
 
-	jobs, total, err := uc.jobRepo.FindAll(page, limit, input.Query, input.Status)
+	filter, err := uc.jobFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	filter.PublishedAt = &now
+
+	jobs, total, err := uc.jobRepo.FindAll(page, limit, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	facets, err := uc.jobRepo.Facets(filter)
 	if err != nil {
 		return nil, err
 	}
 
 	output := make([]dto.GetJobOutputDTO, len(jobs))
-	for i, j := range jobs {
-		output[i] = dto.GetJobOutputDTO{
-			ID:           j.ID,
-			Title:        j.Title,
-			Description:  j.Description,
-			Company:      j.Company,
-			Location:     j.Location,
-			Requirements: j.Requirements,
-			Salary:       j.Salary,
-			Status:       j.Status,
-			CreatedAt:    j.CreatedAt.Format(time.RFC3339),
-			RecruiterID:  j.RecruiterID,
-			RecruiterEmail: func() *string {
-				if j.Anonymous {
-					return nil
-				}
-				e := j.Recruiter.Email
-				return &e
-			}(),
-			Anonymous: j.Anonymous,
-		}
+	for i := range jobs {
+		output[i] = toJobOutput(&jobs[i])
 	}
 
 	totalPages := int((total + int64(limit) - 1) / int64(limit))
@@ -103,37 +234,30 @@ func (uc *JobUseCase) GetAllJobs(input dto.PaginationInputDTO) (*dto.PaginatedJo
 			Limit:      limit,
 			TotalPages: totalPages,
 		},
+		Facets: toFacetsOutput(facets),
 	}, nil
 }
 
-func (uc *JobUseCase) GetJobByID(id uint) (*dto.GetJobOutputDTO, error) {
+// GetJobByID returns a job as candidates see it. Jobs that are not published
+// yet are only found by the recruiters managing them; subject is the zero
+// Subject for anonymous visitors.
+func (uc *JobUseCase) GetJobByID(subject authz.Subject, id uint) (*dto.GetJobOutputDTO, error) {
 	job, err := uc.jobRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
-	return &dto.GetJobOutputDTO{
-		ID:           job.ID,
-		Title:        job.Title,
-		Description:  job.Description,
-		Company:      job.Company,
-		Location:     job.Location,
-		Requirements: job.Requirements,
-		Salary:       job.Salary,
-		Status:       job.Status,
-		CreatedAt:    job.CreatedAt.Format(time.RFC3339),
-		RecruiterID:  job.RecruiterID,
-		RecruiterEmail: func() *string {
-			if job.Anonymous {
-				return nil
-			}
-			e := job.Recruiter.Email
-			return &e
-		}(),
-		Anonymous: job.Anonymous,
-	}, nil
+	if !job.IsPublished(time.Now()) && uc.policy.Authorize(subject, authz.ActionJobUpdate, job) != nil {
+		return nil, errors.New("job not found")
+	}
+	output := toJobOutput(job)
+	return &output, nil
 }
 
-func (uc *JobUseCase) GetRecruiterJobs(recruiterID uint, input dto.PaginationInputDTO) (*dto.PaginatedJobsOutputDTO, error) {
+func (uc *JobUseCase) GetRecruiterJobs(subject authz.Subject, input dto.SearchJobsInputDTO) (*dto.PaginatedJobsOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionJobListMine, nil); err != nil {
+		return nil, err
+	}
+
 	page := input.Page
 	if page <= 0 {
 		page = 1
@@ -143,33 +267,21 @@ func (uc *JobUseCase) GetRecruiterJobs(recruiterID uint, input dto.PaginationInp
 		limit = 10
 	}
 
-	jobs, total, err := uc.jobRepo.FindByRecruiterID(recruiterID, page, limit, input.Query, input.Status)
+	filter, err := uc.jobFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	filter.Archived = input.Archived
+	filter.Deleted = input.Deleted
+
+	jobs, total, err := uc.jobRepo.FindByRecruiterID(subject.UserID, page, limit, filter)
 	if err != nil {
 		return nil, err
 	}
 
 	output := make([]dto.GetJobOutputDTO, len(jobs))
-	for i, j := range jobs {
-		output[i] = dto.GetJobOutputDTO{
-			ID:           j.ID,
-			Title:        j.Title,
-			Description:  j.Description,
-			Company:      j.Company,
-			Location:     j.Location,
-			Requirements: j.Requirements,
-			Salary:       j.Salary,
-			Status:       j.Status,
-			CreatedAt:    j.CreatedAt.Format(time.RFC3339),
-			RecruiterID:  j.RecruiterID,
-			RecruiterEmail: func() *string {
-				if j.Anonymous {
-					return nil
-				}
-				e := j.Recruiter.Email
-				return &e
-			}(),
-			Anonymous: j.Anonymous,
-		}
+	for i := range jobs {
+		output[i] = toRecruiterJobOutput(&jobs[i])
 	}
 
 	totalPages := int((total + int64(limit) - 1) / int64(limit))
@@ -185,65 +297,73 @@ func (uc *JobUseCase) GetRecruiterJobs(recruiterID uint, input dto.PaginationInp
 	}, nil
 }
 
-func (uc *JobUseCase) FinalizeJob(input dto.FinalizeJobInputDTO) error {
-	// 1. Get Job
+// FinalizeJob hires the candidate and closes the job whatever its openings,
+// rejecting the candidates still in the process.
+func (uc *JobUseCase) FinalizeJob(subject authz.Subject, input dto.FinalizeJobInputDTO) error {
 	job, err := uc.jobRepo.FindByID(input.JobID)
 	if err != nil {
 		return err
 	}
 
-	if job.Status != "OPEN" {
-		return errors.New("only OPEN jobs can be finalized")
+	if err := uc.policy.Authorize(subject, authz.ActionJobFinalize, job); err != nil {
+		return err
 	}
 
-	// 2. Update Job Status
-	job.Status = "CLOSED"
-	if err := uc.jobRepo.Update(job); err != nil {
-		return err
+	if !job.CanBecome(domain.JobStatusClosed) {
+		return errors.New("only OPEN or PAUSED jobs can be finalized")
 	}
 
-	// 3. Update applications: hire selected, reject others
+	// Find the candidate's application before touching anything
 	apps, err := uc.appRepo.FindByJobID(input.JobID)
 	if err != nil {
 		return err
 	}
+	app := pendingApplicationOf(apps, input.CandidateID)
+	if app == nil {
+		return errors.New("candidate application not found for this job")
+	}
 
-	found := false
-	for i := range apps {
-		if apps[i].CandidateID == input.CandidateID {
-			apps[i].Status = domain.StatusHired
-			if err := uc.appRepo.Update(&apps[i]); err != nil {
-				return err
-			}
-			found = true
-		} else {
-			if apps[i].Status != domain.StatusCanceled {
-				apps[i].Status = domain.StatusRejected
-				if err := uc.appRepo.Update(&apps[i]); err != nil {
-					return err
-				}
-			}
-		}
+	_, err = uc.hireFor(subject, job, app, nil, true)
+	return err
+}
+
+// PublishJob makes a complete DRAFT job public, or SCHEDULED when its
+// PublishAt is still ahead.
+func (uc *JobUseCase) PublishJob(subject authz.Subject, id uint) (*dto.GetJobOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(id)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobPublish, job); err != nil {
+		return nil, err
 	}
 
-	if !found {
-		return errors.New("candidate application not found for this job")
+	if err := publishJob(job, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.jobRepo.Update(job); err != nil {
+		return nil, err
 	}
 
-	return nil
+	output := toRecruiterJobOutput(job)
+	return &output, nil
 }
 
-func (uc *JobUseCase) UpdateJob(recruiterID uint, id uint, input dto.UpdateJobInputDTO) (*dto.GetJobOutputDTO, error) {
+func (uc *JobUseCase) UpdateJob(subject authz.Subject, id uint, input dto.UpdateJobInputDTO) (*dto.GetJobOutputDTO, error) {
 	job, err := uc.jobRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
-	if job.RecruiterID != recruiterID {
-		return nil, errors.New("unauthorized: job does not belong to recruiter")
+	if err := uc.policy.Authorize(subject, authz.ActionJobUpdate, job); err != nil {
+		return nil, err
+	}
+	if job.Status == domain.JobStatusClosed {
+		return nil, errors.New("closed jobs cannot be updated, reopen them first")
 	}
-	if job.Status == "CLOSED" {
-		return nil, errors.New("only OPEN or PAUSED jobs can be updated")
+	if job.Status == domain.JobStatusPendingApproval {
+		return nil, errors.New("jobs awaiting approval cannot be updated")
 	}
+	from := job.Status
 
 	if input.Title != "" {
 		job.Title = input.Title
@@ -254,48 +374,183 @@ func (uc *JobUseCase) UpdateJob(recruiterID uint, id uint, input dto.UpdateJobIn
 	if input.Company != "" {
 		job.Company = input.Company
 	}
-	if input.Location != "" {
+	if input.Location != "" && input.Location != job.Location {
 		job.Location = input.Location
+		uc.geocode(job)
 	}
 	if input.Requirements != "" {
 		job.Requirements = input.Requirements
 	}
-	if input.Salary != "" {
-		job.Salary = input.Salary
+	if err := uc.applyTaxonomy(job, input.CategoryID, input.EmploymentType, input.Seniority, input.WorkModel); err != nil {
+		return nil, err
+	}
+	if err := applySalary(job, input.Salary); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if err := applySchedule(job, input.PublishAt, input.ExpiresAt, now); err != nil {
+		return nil, err
 	}
 	if input.Status != "" {
-		switch input.Status {
-		case "OPEN", "PAUSED":
-			job.Status = input.Status
-		case "CLOSED":
-			return nil, errors.New("use finalize endpoint to close a job")
-		default:
-			return nil, errors.New("invalid status")
+		if err := changeJobStatus(job, input.Status, now); err != nil {
+			return nil, err
 		}
 	}
 
-	if err := uc.jobRepo.Update(job); err != nil {
+	// Lowering the openings to the hires already made fills the job.
+	var filled bool
+	if input.Openings != nil {
+		if filled, err = uc.setOpenings(job, *input.Openings); err != nil {
+			return nil, err
+		}
+	}
+
+	edit := &domain.JobEdit{Job: job, From: from}
+	if input.Questions != nil {
+		questions, err := buildQuestions(*input.Questions)
+		if err != nil {
+			return nil, err
+		}
+		edit.Questions = &questions
+	}
+	if input.Criteria != nil {
+		criteria, err := buildCriteria(*input.Criteria, job.Criteria)
+		if err != nil {
+			return nil, err
+		}
+		edit.Criteria = &criteria
+	}
+
+	if err := uc.jobRepo.Edit(edit); err != nil {
 		return nil, err
 	}
+	if edit.Questions != nil {
+		job.Questions = *edit.Questions
+	}
+	if edit.Criteria != nil {
+		job.Criteria = *edit.Criteria
+	}
+	if filled {
+		if _, err := uc.hireFor(subject, job, nil, nil, false); err != nil {
+			return nil, err
+		}
+	}
+
+	output := toRecruiterJobOutput(job)
+	return &output, nil
+}
+
+// geocode places the job at its location. Unknown locations, such as remote
+// positions, and geocoder failures leave it without coordinates.
+func (uc *JobUseCase) geocode(job *domain.Job) {
+	job.Latitude, job.Longitude = nil, nil
+	point, ok, err := uc.geocoder.Geocode(job.Location)
+	if err != nil {
+		log.Println("Job: failed to geocode", job.Location+":", err)
+		return
+	}
+	if ok {
+		job.Latitude, job.Longitude = &point.Lat, &point.Lng
+	}
+}
 
-	return &dto.GetJobOutputDTO{
-		ID:           job.ID,
-		Title:        job.Title,
-		Description:  job.Description,
-		Company:      job.Company,
-		Location:     job.Location,
-		Requirements: job.Requirements,
-		Salary:       job.Salary,
-		Status:       job.Status,
-		CreatedAt:    job.CreatedAt.Format(time.RFC3339),
-		RecruiterID:  job.RecruiterID,
-		RecruiterEmail: func() *string {
-			if job.Anonymous {
-				return nil
+// applyTaxonomy validates and sets the job's classification. Empty values
+// leave the current ones untouched; a category ID of 0 removes the category.
+func (uc *JobUseCase) applyTaxonomy(job *domain.Job, categoryID *uint, employmentType, seniority, workModel string) error {
+	if categoryID != nil {
+		if *categoryID == 0 {
+			job.CategoryID, job.Category = nil, nil
+		} else {
+			category, err := uc.categoryRepo.FindByID(*categoryID)
+			if err != nil {
+				return errors.New("category not found")
 			}
-			e := job.Recruiter.Email
-			return &e
-		}(),
-		Anonymous: job.Anonymous,
-	}, nil
+			job.CategoryID, job.Category = &category.ID, category
+		}
+	}
+
+	if employmentType != "" {
+		t := domain.EmploymentType(strings.ToUpper(employmentType))
+		if !t.IsValid() {
+			return errors.New("employment type must be CLT, PJ, CONTRACT or INTERNSHIP")
+		}
+		job.EmploymentType = t
+	}
+	if seniority != "" {
+		level := domain.Seniority(strings.ToUpper(seniority))
+		if !level.IsValid() {
+			return errors.New("seniority must be INTERN, JUNIOR, MID, SENIOR or LEAD")
+		}
+		job.Seniority = level
+	}
+	if workModel != "" {
+		m := domain.WorkModel(strings.ToUpper(workModel))
+		if !m.IsValid() {
+			return errors.New("work model must be REMOTE, HYBRID or ONSITE")
+		}
+		job.WorkModel = m
+	}
+	return nil
+}
+
+func toJobOutput(job *domain.Job) dto.GetJobOutputDTO {
+	output := dto.GetJobOutputDTO{
+		ID:             job.ID,
+		Title:          job.Title,
+		Description:    job.Description,
+		Company:        job.Company,
+		Location:       job.Location,
+		Requirements:   job.Requirements,
+		Latitude:       job.Latitude,
+		Longitude:      job.Longitude,
+		Category:       toCategoryOutput(job.Category),
+		EmploymentType: string(job.EmploymentType),
+		Seniority:      string(job.Seniority),
+		WorkModel:      string(job.WorkModel),
+		Status:         job.Status,
+		Openings:       job.Openings,
+		PublishAt:      formatOptionalTime(job.PublishAt),
+		ExpiresAt:      formatOptionalTime(job.ExpiresAt),
+		CreatedAt:      job.CreatedAt.Format(time.RFC3339),
+		RecruiterID:    job.RecruiterID,
+		Anonymous:      job.Anonymous,
+	}
+	if job.OrganizationID != nil {
+		output.OrganizationID = *job.OrganizationID
+	}
+	if !job.SalaryHidden {
+		output.Salary = toSalaryOutput(job)
+		output.SalaryText = job.Salary
+	}
+	if job.Match.DistanceKm != nil {
+		km := math.Round(*job.Match.DistanceKm*10) / 10
+		output.DistanceKm = &km
+	}
+	if job.Match.TitleHighlight != "" || job.Match.DescriptionHighlight != "" {
+		output.Highlights = &dto.JobHighlightsDTO{
+			Title:       job.Match.TitleHighlight,
+			Description: job.Match.DescriptionHighlight,
+		}
+	}
+	output.Questions = toQuestionOutputs(job.Questions, false)
+	if !job.Anonymous {
+		e := job.Recruiter.Email
+		output.RecruiterEmail = &e
+	}
+	return output
+}
+
+// toRecruiterJobOutput is toJobOutput for the job's own recruiters: hidden
+// salaries and knockout rules are included.
+func toRecruiterJobOutput(job *domain.Job) dto.GetJobOutputDTO {
+	output := toJobOutput(job)
+	output.Salary = toSalaryOutput(job)
+	output.SalaryText = job.Salary
+	output.ArchivedAt = formatOptionalTime(job.ArchivedAt)
+	if job.DeletedAt.Valid {
+		output.DeletedAt = formatOptionalTime(&job.DeletedAt.Time)
+	}
+	output.Questions = toQuestionOutputs(job.Questions, true)
+	output.Criteria = toCriterionOutputs(job.Criteria)
+	return output
 }
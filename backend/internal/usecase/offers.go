@@ -0,0 +1,494 @@
+package usecase
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const (
+	maxOfferBenefits     = 2000
+	maxDeclineReason     = 1000
+	maxOfferTemplateSize = 20000
+	offerDateLayout      = "January 2, 2006"
+)
+
+// offerLetter is what offer letter templates can use.
+type offerLetter struct {
+	Date          string
+	CandidateName string
+	JobTitle      string
+	Company       string
+	Salary        string
+	StartDate     string
+	Benefits      string
+	ExpiresAt     string
+	SenderName    string
+}
+
+// GetOffers lists the offers of an application, newest first. Candidates only
+// see the offers sent to them, not drafts.
+func (uc *JobUseCase) GetOffers(subject authz.Subject, appID uint) ([]dto.OfferOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(appID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationOffers, app); err != nil {
+		return nil, err
+	}
+
+	offers, err := uc.offerRepo.FindByApplicationID(app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := []dto.OfferOutputDTO{}
+	for i := range offers {
+		if hiddenOffer(subject, &offers[i]) {
+			continue
+		}
+		if err := uc.expireLapsed(&offers[i]); err != nil {
+			return nil, err
+		}
+		output = append(output, toOfferOutput(&offers[i]))
+	}
+	return output, nil
+}
+
+// CreateOffer drafts an offer for a pending application. It reaches the
+// candidate once sent.
+func (uc *JobUseCase) CreateOffer(subject authz.Subject, input dto.CreateOfferInputDTO) (*dto.OfferOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(input.ApplicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationOffer, app); err != nil {
+		return nil, err
+	}
+
+	if app.Status != domain.StatusPending {
+		return nil, errors.New("offers can only be made to pending applications")
+	}
+	if !app.Job.CanBecome(domain.JobStatusClosed) {
+		return nil, errors.New("only OPEN or PAUSED jobs can make offers")
+	}
+
+	offer := &domain.Offer{
+		ApplicationID:  app.ID,
+		Application:    *app,
+		Salary:         input.Salary,
+		SalaryCurrency: input.Currency,
+		SalaryPeriod:   domain.SalaryPeriod(input.Period),
+		StartDate:      input.StartDate,
+		Benefits:       input.Benefits,
+		ExpiresAt:      input.ExpiresAt,
+		Status:         domain.OfferDraft,
+		CreatedByID:    subject.UserID,
+	}
+	if err := checkOfferTerms(offer, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.offerRepo.Create(offer); err != nil {
+		return nil, err
+	}
+
+	// Reload for the author's name.
+	created, err := uc.offerRepo.FindByID(offer.ID)
+	if err != nil {
+		return nil, err
+	}
+	output := toOfferOutput(created)
+	return &output, nil
+}
+
+// UpdateOffer changes the terms of a draft offer.
+func (uc *JobUseCase) UpdateOffer(subject authz.Subject, input dto.UpdateOfferInputDTO) (*dto.OfferOutputDTO, error) {
+	offer, err := uc.offerRepo.FindByID(input.OfferID)
+	if err != nil {
+		return nil, errors.New("offer not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationOffer, &offer.Application); err != nil {
+		return nil, err
+	}
+
+	if offer.Status != domain.OfferDraft {
+		return nil, errors.New("only draft offers can be changed")
+	}
+
+	if input.Salary != nil {
+		offer.Salary = *input.Salary
+	}
+	if input.Currency != nil {
+		offer.SalaryCurrency = *input.Currency
+	}
+	if input.Period != nil {
+		offer.SalaryPeriod = domain.SalaryPeriod(*input.Period)
+	}
+	if input.StartDate != nil {
+		offer.StartDate = *input.StartDate
+	}
+	if input.Benefits != nil {
+		offer.Benefits = *input.Benefits
+	}
+	if input.ExpiresAt != nil {
+		offer.ExpiresAt = *input.ExpiresAt
+	}
+	if err := checkOfferTerms(offer, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.offerRepo.Update(offer); err != nil {
+		return nil, err
+	}
+
+	output := toOfferOutput(offer)
+	return &output, nil
+}
+
+// SendOffer sends a draft offer to the candidate, emailing them the offer
+// letter. Its terms cannot change afterwards.
+func (uc *JobUseCase) SendOffer(subject authz.Subject, offerID uint) (*dto.OfferOutputDTO, error) {
+	offer, err := uc.offerRepo.FindByID(offerID)
+	if err != nil {
+		return nil, errors.New("offer not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationOffer, &offer.Application); err != nil {
+		return nil, err
+	}
+
+	if offer.Status != domain.OfferDraft {
+		return nil, errors.New("only draft offers can be sent")
+	}
+	if offer.Application.Status != domain.StatusPending {
+		return nil, errors.New("offers can only be made to pending applications")
+	}
+	if !offer.Application.Job.CanBecome(domain.JobStatusClosed) {
+		return nil, errors.New("only OPEN or PAUSED jobs can make offers")
+	}
+	now := time.Now()
+	if !offer.ExpiresAt.After(now) {
+		return nil, errors.New("the offer has already expired, change expires_at first")
+	}
+
+	letter, err := uc.offerLetter(offer, now)
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Status = domain.OfferSent
+	offer.SentAt = &now
+	if err := uc.offerRepo.Update(offer); err != nil {
+		return nil, err
+	}
+
+	candidate := offer.Application.Candidate
+	err = uc.mailer.Send(domain.MailMessage{
+		To:      []string{candidate.Email},
+		Subject: fmt.Sprintf("Job offer: %s", offer.Application.Job.Title),
+		Body: fmt.Sprintf("Hello %s,\n\nYou have received an offer for %s. The offer letter is attached.\n\nAccept or decline it before %s on your applications page:\n\n%s/applications\n",
+			candidate.Name, offer.Application.Job.Title, offer.ExpiresAt.UTC().Format(interviewTimeLayout), uc.appURL),
+		Attachments: []domain.MailAttachment{{Filename: "offer-letter.pdf", ContentType: "application/pdf", Data: letter}},
+	})
+	if err != nil {
+		log.Println("Offer: failed to send offer email:", err)
+	}
+
+	output := toOfferOutput(offer)
+	return &output, nil
+}
+
+// AcceptOffer accepts a sent offer on behalf of its candidate and hires them
+// in one transaction, which closes the job when it fills its last opening.
+func (uc *JobUseCase) AcceptOffer(subject authz.Subject, offerID uint) (*dto.AcceptOfferOutputDTO, error) {
+	offer, err := uc.offerToAnswer(subject, offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := uc.jobRepo.FindByID(offer.Application.JobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if !job.CanBecome(domain.JobStatusClosed) {
+		return nil, errors.New("this job is no longer hiring")
+	}
+	apps, err := uc.appRepo.FindByJobID(job.ID)
+	if err != nil {
+		return nil, err
+	}
+	app := pendingApplicationOf(apps, offer.Application.CandidateID)
+	if app == nil || app.ID != offer.ApplicationID {
+		return nil, errors.New("your application is no longer in the hiring process")
+	}
+	if err := checkOpenings(job, apps); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	offer.Status = domain.OfferAccepted
+	offer.RespondedAt = &now
+	result, err := uc.hireFor(subject, job, app, offer, false)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notifyOfferAnswer(offer)
+
+	return &dto.AcceptOfferOutputDTO{Offer: toOfferOutput(offer), Hiring: toHireOutput(job.ID, result)}, nil
+}
+
+// DeclineOffer declines a sent offer on behalf of its candidate. The
+// application stays in the process, so the team can make another offer.
+func (uc *JobUseCase) DeclineOffer(subject authz.Subject, offerID uint, reason string) (*dto.OfferOutputDTO, error) {
+	offer, err := uc.offerToAnswer(subject, offerID)
+	if err != nil {
+		return nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > maxDeclineReason {
+		return nil, fmt.Errorf("reason can have at most %d characters", maxDeclineReason)
+	}
+
+	now := time.Now()
+	offer.Status = domain.OfferDeclined
+	offer.RespondedAt = &now
+	offer.DeclineReason = reason
+	if err := uc.offerRepo.Respond(offer); err != nil {
+		return nil, err
+	}
+
+	uc.notifyOfferAnswer(offer)
+
+	output := toOfferOutput(offer)
+	return &output, nil
+}
+
+// OfferLetter renders the offer letter of an offer as a PDF.
+func (uc *JobUseCase) OfferLetter(subject authz.Subject, offerID uint) ([]byte, error) {
+	offer, err := uc.offerRepo.FindByID(offerID)
+	if err != nil {
+		return nil, errors.New("offer not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationOffers, &offer.Application); err != nil {
+		return nil, err
+	}
+	if hiddenOffer(subject, offer) {
+		return nil, errors.New("offer not found")
+	}
+
+	date := time.Now()
+	if offer.SentAt != nil {
+		date = *offer.SentAt
+	}
+	return uc.offerLetter(offer, date)
+}
+
+// offerToAnswer loads a sent offer the subject may accept or decline.
+func (uc *JobUseCase) offerToAnswer(subject authz.Subject, offerID uint) (*domain.Offer, error) {
+	offer, err := uc.offerRepo.FindByID(offerID)
+	if err != nil {
+		return nil, errors.New("offer not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationRespond, &offer.Application); err != nil {
+		return nil, err
+	}
+
+	if err := uc.expireLapsed(offer); err != nil {
+		return nil, err
+	}
+	switch offer.Status {
+	case domain.OfferSent:
+		return offer, nil
+	case domain.OfferDraft:
+		return nil, errors.New("offer not found")
+	case domain.OfferExpired:
+		return nil, errors.New("the offer has expired")
+	default:
+		return nil, errors.New("the offer was already answered")
+	}
+}
+
+// expireLapsed marks a sent offer past its expiry as EXPIRED without waiting
+// for the scheduler.
+func (uc *JobUseCase) expireLapsed(offer *domain.Offer) error {
+	now := time.Now()
+	if !offer.Lapsed(now) {
+		return nil
+	}
+	return uc.offerRepo.Expire(offer, now)
+}
+
+func (uc *JobUseCase) notifyOfferAnswer(offer *domain.Offer) {
+	answer := "accepted"
+	if offer.Status == domain.OfferDeclined {
+		answer = "declined"
+	}
+	body := fmt.Sprintf("Hello %s,\n\n%s has %s the offer for %s.", offer.CreatedBy.Name, offer.Application.Candidate.Name, answer, offer.Application.Job.Title)
+	if offer.DeclineReason != "" {
+		body += "\n\nReason: " + offer.DeclineReason
+	}
+	body += fmt.Sprintf("\n\n%s/jobs/%d/manage\n", uc.appURL, offer.Application.JobID)
+
+	err := uc.mailer.Send(domain.MailMessage{
+		To:      []string{offer.CreatedBy.Email},
+		Subject: fmt.Sprintf("Offer %s: %s", answer, offer.Application.Job.Title),
+		Body:    body,
+	})
+	if err != nil {
+		log.Println("Offer: failed to send answer email:", err)
+	}
+}
+
+// offerLetter renders the letter from the organization's template, or the
+// default one.
+func (uc *JobUseCase) offerLetter(offer *domain.Offer, date time.Time) ([]byte, error) {
+	job := &offer.Application.Job
+	text := domain.DefaultOfferLetterTemplate
+	if job.Organization != nil && job.Organization.OfferLetterTemplate != "" {
+		text = job.Organization.OfferLetterTemplate
+	}
+
+	tmpl, err := template.New("offer").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer letter template: %w", err)
+	}
+	var letter bytes.Buffer
+	err = tmpl.Execute(&letter, offerLetter{
+		Date:          date.Format(offerDateLayout),
+		CandidateName: offer.Application.Candidate.Name,
+		JobTitle:      job.Title,
+		Company:       job.Company,
+		Salary:        formatOfferSalary(offer),
+		StartDate:     offer.StartDate.Format(offerDateLayout),
+		Benefits:      offer.Benefits,
+		ExpiresAt:     offer.ExpiresAt.UTC().Format(interviewTimeLayout),
+		SenderName:    offer.CreatedBy.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid offer letter template: %w", err)
+	}
+	return encodePDF("Offer letter: "+job.Title, letter.String()), nil
+}
+
+// checkOfferTemplate makes sure an organization's template renders before it
+// is saved.
+func checkOfferTemplate(text string) error {
+	if len(text) > maxOfferTemplateSize {
+		return fmt.Errorf("offer letter template can have at most %d bytes", maxOfferTemplateSize)
+	}
+	tmpl, err := template.New("offer").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid offer letter template: %w", err)
+	}
+	if err := tmpl.Execute(&bytes.Buffer{}, offerLetter{}); err != nil {
+		return fmt.Errorf("invalid offer letter template: %w", err)
+	}
+	return nil
+}
+
+// checkOfferTerms validates the terms of an offer, filling in the default
+// currency and period.
+func checkOfferTerms(offer *domain.Offer, now time.Time) error {
+	if offer.Salary <= 0 {
+		return errors.New("salary must be positive")
+	}
+
+	offer.SalaryCurrency = strings.ToUpper(strings.TrimSpace(offer.SalaryCurrency))
+	if offer.SalaryCurrency == "" {
+		offer.SalaryCurrency = domain.DefaultSalaryCurrency
+	}
+	if !domain.IsCurrencyCode(offer.SalaryCurrency) {
+		return errors.New("currency must be a 3-letter ISO 4217 code")
+	}
+	offer.SalaryPeriod = domain.SalaryPeriod(strings.ToUpper(strings.TrimSpace(string(offer.SalaryPeriod))))
+	if offer.SalaryPeriod == "" {
+		offer.SalaryPeriod = domain.SalaryPerMonth
+	}
+	if !offer.SalaryPeriod.IsValid() {
+		return errors.New("period must be HOUR, MONTH or YEAR")
+	}
+
+	if offer.StartDate.IsZero() {
+		return errors.New("start_date is required")
+	}
+	if offer.StartDate.Before(now.Truncate(24 * time.Hour)) {
+		return errors.New("start_date cannot be in the past")
+	}
+	if offer.ExpiresAt.IsZero() {
+		return errors.New("expires_at is required")
+	}
+	if !offer.ExpiresAt.After(now) {
+		return errors.New("expires_at must be in the future")
+	}
+
+	offer.Benefits = strings.TrimSpace(offer.Benefits)
+	if utf8.RuneCountInString(offer.Benefits) > maxOfferBenefits {
+		return fmt.Errorf("benefits can have at most %d characters", maxOfferBenefits)
+	}
+	return nil
+}
+
+// hiddenOffer reports whether the offer is a draft the subject, its candidate,
+// must not see yet.
+func hiddenOffer(subject authz.Subject, offer *domain.Offer) bool {
+	return offer.Status == domain.OfferDraft && offer.Application.CandidateID == subject.UserID
+}
+
+var offerPeriods = map[domain.SalaryPeriod]string{
+	domain.SalaryPerHour:  "per hour",
+	domain.SalaryPerMonth: "per month",
+	domain.SalaryPerYear:  "per year",
+}
+
+// formatOfferSalary writes the salary as e.g. "BRL 8,500 per month".
+func formatOfferSalary(offer *domain.Offer) string {
+	digits := strconv.Itoa(offer.Salary)
+	var amount strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			amount.WriteByte(',')
+		}
+		amount.WriteRune(d)
+	}
+	return fmt.Sprintf("%s %s %s", offer.SalaryCurrency, amount.String(), offerPeriods[offer.SalaryPeriod])
+}
+
+func toOfferOutput(offer *domain.Offer) dto.OfferOutputDTO {
+	return dto.OfferOutputDTO{
+		ID:            offer.ID,
+		ApplicationID: offer.ApplicationID,
+		JobID:         offer.Application.JobID,
+		JobTitle:      offer.Application.Job.Title,
+		CandidateID:   offer.Application.CandidateID,
+		CandidateName: offer.Application.Candidate.Name,
+		Salary:        offer.Salary,
+		Currency:      offer.SalaryCurrency,
+		Period:        string(offer.SalaryPeriod),
+		StartDate:     offer.StartDate.Format("2006-01-02"),
+		Benefits:      offer.Benefits,
+		ExpiresAt:     offer.ExpiresAt.Format(time.RFC3339),
+		Status:        string(offer.Status),
+		CreatedByID:   offer.CreatedByID,
+		CreatedByName: offer.CreatedBy.Name,
+		SentAt:        formatOptionalTime(offer.SentAt),
+		RespondedAt:   formatOptionalTime(offer.RespondedAt),
+		DeclineReason: offer.DeclineReason,
+		CreatedAt:     offer.CreatedAt.Format(time.RFC3339),
+	}
+}
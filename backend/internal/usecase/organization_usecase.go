@@ -0,0 +1,340 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const invitationTTL = 7 * 24 * time.Hour
+
+type OrganizationUseCase struct {
+	orgRepo  domain.OrganizationRepository
+	userRepo domain.UserRepository
+	mailer   domain.MailSender
+	policy   *authz.Policy
+	appURL   string
+}
+
+func NewOrganizationUseCase(orgRepo domain.OrganizationRepository, userRepo domain.UserRepository, mailer domain.MailSender, policy *authz.Policy, appURL string) *OrganizationUseCase {
+	return &OrganizationUseCase{
+		orgRepo:  orgRepo,
+		userRepo: userRepo,
+		mailer:   mailer,
+		policy:   policy,
+		appURL:   appURL,
+	}
+}
+
+func (uc *OrganizationUseCase) Create(subject authz.Subject, input dto.CreateOrganizationInputDTO) (*dto.OrganizationOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionOrganizationCreate, nil); err != nil {
+		return nil, err
+	}
+
+	org := &domain.Organization{Name: strings.TrimSpace(input.Name)}
+	if org.Name == "" {
+		return nil, errors.New("organization name is required")
+	}
+
+	if err := uc.orgRepo.CreateWithOwner(org, subject.UserID); err != nil {
+		return nil, err
+	}
+
+	output := toOrganizationOutput(org, domain.OrgRoleOwner)
+	return &output, nil
+}
+
+func (uc *OrganizationUseCase) GetMyOrganizations(subject authz.Subject) ([]dto.OrganizationOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionOrganizationListMine, nil); err != nil {
+		return nil, err
+	}
+
+	memberships, err := uc.orgRepo.FindByUserID(subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.OrganizationOutputDTO, len(memberships))
+	for i, m := range memberships {
+		output[i] = toOrganizationOutput(&m.Organization, m.Role)
+	}
+	return output, nil
+}
+
+func (uc *OrganizationUseCase) GetMembers(subject authz.Subject, orgID uint) ([]dto.OrganizationMemberOutputDTO, error) {
+	if _, err := uc.authorizeOrganization(subject, authz.ActionOrganizationView, orgID); err != nil {
+		return nil, err
+	}
+
+	members, err := uc.orgRepo.FindMembers(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.OrganizationMemberOutputDTO, len(members))
+	for i, m := range members {
+		output[i] = dto.OrganizationMemberOutputDTO{
+			UserID:   m.UserID,
+			Name:     m.User.Name,
+			Email:    m.User.Email,
+			Role:     string(m.Role),
+			JoinedAt: m.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return output, nil
+}
+
+// Update renames the organization and turns job approval on or off. Turning
+// it off leaves pending approval requests to be decided.
+func (uc *OrganizationUseCase) Update(subject authz.Subject, orgID uint, input dto.UpdateOrganizationInputDTO) (*dto.OrganizationOutputDTO, error) {
+	org, err := uc.authorizeOrganization(subject, authz.ActionOrganizationManage, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if name := strings.TrimSpace(input.Name); name != "" {
+		org.Name = name
+	}
+	if input.RequireJobApproval != nil {
+		org.RequireJobApproval = *input.RequireJobApproval
+	}
+	if input.OfferLetterTemplate != nil {
+		template := strings.TrimSpace(*input.OfferLetterTemplate)
+		if template != "" {
+			if err := checkOfferTemplate(template); err != nil {
+				return nil, err
+			}
+		}
+		org.OfferLetterTemplate = template
+	}
+
+	if err := uc.orgRepo.Update(org); err != nil {
+		return nil, err
+	}
+
+	output := toOrganizationOutput(org, domain.OrgRoleOwner)
+	return &output, nil
+}
+
+// UpdateMemberRole changes what a member may do: recruiters manage jobs,
+// hiring managers also approve them and owners also manage the organization.
+func (uc *OrganizationUseCase) UpdateMemberRole(subject authz.Subject, input dto.UpdateMemberInputDTO) (*dto.OrganizationMemberOutputDTO, error) {
+	if _, err := uc.authorizeOrganization(subject, authz.ActionOrganizationManage, input.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	role := domain.OrganizationRole(strings.ToUpper(strings.TrimSpace(input.Role)))
+	switch role {
+	case domain.OrgRoleOwner, domain.OrgRoleRecruiter, domain.OrgRoleHiringManager:
+	default:
+		return nil, errors.New("invalid role")
+	}
+
+	member, err := uc.orgRepo.FindMember(input.OrganizationID, input.UserID)
+	if err != nil {
+		return nil, errors.New("member not found")
+	}
+
+	if member.Role == domain.OrgRoleOwner && role != domain.OrgRoleOwner {
+		owners, err := uc.orgRepo.CountOwners(input.OrganizationID)
+		if err != nil {
+			return nil, err
+		}
+		if owners <= 1 {
+			return nil, errors.New("an organization must keep at least one owner")
+		}
+	}
+
+	member.Role = role
+	if err := uc.orgRepo.UpdateMember(member); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.FindByID(member.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.OrganizationMemberOutputDTO{
+		UserID:   member.UserID,
+		Name:     user.Name,
+		Email:    user.Email,
+		Role:     string(member.Role),
+		JoinedAt: member.CreatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (uc *OrganizationUseCase) RemoveMember(subject authz.Subject, orgID, userID uint) error {
+	if _, err := uc.authorizeOrganization(subject, authz.ActionOrganizationManage, orgID); err != nil {
+		return err
+	}
+
+	member, err := uc.orgRepo.FindMember(orgID, userID)
+	if err != nil {
+		return errors.New("member not found")
+	}
+
+	if member.Role == domain.OrgRoleOwner {
+		owners, err := uc.orgRepo.CountOwners(orgID)
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return errors.New("an organization must keep at least one owner")
+		}
+	}
+
+	return uc.orgRepo.RemoveMember(orgID, userID)
+}
+
+func (uc *OrganizationUseCase) Invite(subject authz.Subject, input dto.InviteMemberInputDTO) (*dto.InvitationOutputDTO, error) {
+	org, err := uc.authorizeOrganization(subject, authz.ActionOrganizationManage, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	if invitee, err := uc.userRepo.FindByEmail(email); err == nil {
+		if _, err := uc.orgRepo.FindMember(org.ID, invitee.ID); err == nil {
+			return nil, errors.New("user is already a member of this organization")
+		}
+	}
+
+	token, err := randomToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := &domain.OrganizationInvitation{
+		OrganizationID: org.ID,
+		Email:          email,
+		InvitedByID:    subject.UserID,
+		TokenHash:      hashToken(token),
+		Status:         domain.InvitationPending,
+		ExpiresAt:      time.Now().Add(invitationTTL),
+	}
+	if err := uc.orgRepo.CreateInvitation(invitation); err != nil {
+		return nil, err
+	}
+
+	err = uc.mailer.Send(domain.MailMessage{
+		To:      []string{email},
+		Subject: fmt.Sprintf("You have been invited to join %s", org.Name),
+		Body: fmt.Sprintf("Hello,\n\nYou have been invited to join the hiring team of %s. Sign in with a recruiter account using this email and open the link below within %d days:\n\n%s/invitations/accept?token=%s\n",
+			org.Name, int(invitationTTL.Hours()/24), uc.appURL, token),
+	})
+	if err != nil {
+		log.Println("Organization: failed to send invitation email:", err)
+	}
+
+	output := toInvitationOutput(invitation)
+	return &output, nil
+}
+
+func (uc *OrganizationUseCase) GetPendingInvitations(subject authz.Subject, orgID uint) ([]dto.InvitationOutputDTO, error) {
+	if _, err := uc.authorizeOrganization(subject, authz.ActionOrganizationView, orgID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := uc.orgRepo.FindPendingInvitations(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]dto.InvitationOutputDTO, len(invitations))
+	for i := range invitations {
+		output[i] = toInvitationOutput(&invitations[i])
+	}
+	return output, nil
+}
+
+func (uc *OrganizationUseCase) RevokeInvitation(subject authz.Subject, orgID, invitationID uint) error {
+	if _, err := uc.authorizeOrganization(subject, authz.ActionOrganizationManage, orgID); err != nil {
+		return err
+	}
+
+	invitation, err := uc.orgRepo.FindInvitationByID(invitationID)
+	if err != nil || invitation.OrganizationID != orgID {
+		return errors.New("invitation not found")
+	}
+	if invitation.Status != domain.InvitationPending {
+		return errors.New("only pending invitations can be revoked")
+	}
+
+	invitation.Status = domain.InvitationRevoked
+	return uc.orgRepo.UpdateInvitation(invitation)
+}
+
+func (uc *OrganizationUseCase) AcceptInvitation(subject authz.Subject, token string) (*dto.OrganizationOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionOrganizationJoin, nil); err != nil {
+		return nil, err
+	}
+
+	invitation, err := uc.orgRepo.FindInvitationByHash(hashToken(token))
+	if err != nil || invitation.Status != domain.InvitationPending || time.Now().After(invitation.ExpiresAt) {
+		return nil, errors.New("invalid or expired invitation")
+	}
+
+	user, err := uc.userRepo.FindByID(subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, errors.New("this invitation was sent to a different email address")
+	}
+
+	if err := uc.orgRepo.AcceptInvitation(invitation, subject.UserID); err != nil {
+		return nil, err
+	}
+
+	member, err := uc.orgRepo.FindMember(invitation.OrganizationID, subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := toOrganizationOutput(&invitation.Organization, member.Role)
+	return &output, nil
+}
+
+func (uc *OrganizationUseCase) authorizeOrganization(subject authz.Subject, action authz.Action, orgID uint) (*domain.Organization, error) {
+	org, err := uc.orgRepo.FindByID(orgID)
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+	if err := uc.policy.Authorize(subject, action, org); err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// toOrganizationOutput shows the offer letter template to owners only, who
+// are the ones who can change it.
+func toOrganizationOutput(org *domain.Organization, role domain.OrganizationRole) dto.OrganizationOutputDTO {
+	output := dto.OrganizationOutputDTO{
+		ID:                 org.ID,
+		Name:               org.Name,
+		Role:               string(role),
+		RequireJobApproval: org.RequireJobApproval,
+		CreatedAt:          org.CreatedAt.Format(time.RFC3339),
+	}
+	if role == domain.OrgRoleOwner {
+		output.OfferLetterTemplate = org.OfferLetterTemplate
+	}
+	return output
+}
+
+func toInvitationOutput(invitation *domain.OrganizationInvitation) dto.InvitationOutputDTO {
+	return dto.InvitationOutputDTO{
+		ID:             invitation.ID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+		Status:         string(invitation.Status),
+		ExpiresAt:      invitation.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:      invitation.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Layout of generated documents: A4 pages, 11pt Helvetica.
+const (
+	pdfPageWidth   = 595
+	pdfPageHeight  = 842
+	pdfMargin      = 64
+	pdfFontSize    = 11
+	pdfLeading     = 15
+	pdfLineRunes   = 86
+	pdfLinesOnPage = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+)
+
+// encodePDF lays plain text out as a PDF 1.4 document, wrapping long lines at
+// word boundaries and starting new pages as needed. Characters Windows-1252
+// cannot encode are printed as '?'.
+func encodePDF(title, text string) []byte {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		lines = append(lines, wrapPDFLine(line, pdfLineRunes)...)
+	}
+	var pages [][]string
+	for len(lines) > pdfLinesOnPage {
+		pages = append(pages, lines[:pdfLinesOnPage])
+		lines = lines[pdfLinesOnPage:]
+	}
+	pages = append(pages, lines)
+
+	// Objects 1 to 4 are the catalog, page tree, font and document info; each
+	// page then takes two objects, itself and its content stream.
+	objects := make([]string, 4, 4+2*len(pages))
+	kids := make([]string, len(pages))
+	for i, page := range pages {
+		pageID := 5 + 2*i
+		kids[i] = fmt.Sprintf("%d 0 R", pageID)
+
+		var content strings.Builder
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", pdfString(line))
+		}
+		content.WriteString("ET")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, pageID+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		)
+	}
+	objects[0] = "<< /Type /Catalog /Pages 2 0 R >>"
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+	objects[2] = "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"
+	objects[3] = fmt.Sprintf("<< /Title (%s) /Producer (Recruitment System) >>", pdfString(title))
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes()
+}
+
+// wrapPDFLine splits a line into lines of at most limit runes, breaking at
+// spaces when it can.
+func wrapPDFLine(line string, limit int) []string {
+	line = strings.TrimRight(line, " \t")
+	if utf8.RuneCountInString(line) <= limit {
+		return []string{line}
+	}
+
+	var lines []string
+	var current []rune
+	for _, word := range strings.Fields(line) {
+		w := []rune(word)
+		for len(w) > limit {
+			if len(current) > 0 {
+				lines = append(lines, string(current))
+				current = nil
+			}
+			lines = append(lines, string(w[:limit]))
+			w = w[limit:]
+		}
+		if len(current) > 0 && len(current)+1+len(w) > limit {
+			lines = append(lines, string(current))
+			current = nil
+		}
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, w...)
+	}
+	if len(current) > 0 {
+		lines = append(lines, string(current))
+	}
+	return lines
+}
+
+// pdfWinAnsi maps the characters Windows-1252 places in 0x80-0x9F.
+var pdfWinAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+	'˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// pdfString encodes s as the body of a PDF literal string in WinAnsiEncoding,
+// escaping delimiters and writing bytes outside ASCII in octal.
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		var c byte
+		switch {
+		case r == '\t':
+			c = ' '
+		case r >= 0x20 && r < 0x7F:
+			c = byte(r)
+		case r >= 0xA0 && r <= 0xFF:
+			c = byte(r)
+		default:
+			var ok bool
+			if c, ok = pdfWinAnsi[r]; !ok {
+				c = '?'
+			}
+		}
+
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 0x80:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/helberthlucas14/internal/domain"
+)
+
+const maxPipelineStages = 20
+
+// pipelines resolves the hiring pipeline that applies to a job: the job's own
+// stages if it has any, otherwise its organization's default stages, which are
+// created from domain.DefaultPipelineStages the first time they are needed.
+type pipelines struct {
+	repo domain.PipelineRepository
+}
+
+func (p pipelines) forJob(job *domain.Job) ([]domain.PipelineStage, error) {
+	stages, err := p.repo.FindByJobID(job.ID)
+	if err != nil || len(stages) > 0 {
+		return stages, err
+	}
+	if job.OrganizationID == nil {
+		return nil, errors.New("job does not belong to an organization")
+	}
+	return p.forOrganization(*job.OrganizationID)
+}
+
+func (p pipelines) forOrganization(orgID uint) ([]domain.PipelineStage, error) {
+	stages, err := p.repo.FindByOrganizationID(orgID)
+	if err != nil || len(stages) > 0 {
+		return stages, err
+	}
+
+	stages, _, err = buildStages(orgID, nil, domain.DefaultPipelineStages, nil)
+	if err != nil {
+		return nil, err
+	}
+	return p.repo.CreateDefaultStages(orgID, stages)
+}
+
+// buildStages turns an ordered list of active stage names into stage rows,
+// always followed by the terminal HIRED and REJECTED stages. Rows in existing
+// whose name (or terminal kind) matches are reused so applications keep their
+// stage; the IDs of the remaining existing rows are returned for removal.
+func buildStages(orgID uint, jobID *uint, names []string, existing []domain.PipelineStage) ([]domain.PipelineStage, []uint, error) {
+	if len(names) == 0 {
+		return nil, nil, errors.New("a pipeline needs at least one stage")
+	}
+	if len(names) > maxPipelineStages {
+		return nil, nil, fmt.Errorf("a pipeline can have at most %d stages", maxPipelineStages)
+	}
+
+	byKey := make(map[string]domain.PipelineStage, len(existing))
+	for _, s := range existing {
+		byKey[stageKey(s.Kind, s.Name)] = s
+	}
+
+	stages := make([]domain.PipelineStage, 0, len(names)+2)
+	seen := make(map[string]bool, len(names))
+	appendStage := func(name string, kind domain.StageKind) {
+		key := stageKey(kind, name)
+		stage, ok := byKey[key]
+		if !ok {
+			stage = domain.PipelineStage{OrganizationID: orgID, JobID: jobID, Kind: kind}
+		}
+		delete(byKey, key)
+		stage.Name = name
+		stage.Position = len(stages) + 1
+		stages = append(stages, stage)
+	}
+
+	for _, raw := range names {
+		name := strings.TrimSpace(raw)
+		if name == "" {
+			return nil, nil, errors.New("stage names cannot be empty")
+		}
+		key := strings.ToLower(name)
+		if seen[key] {
+			return nil, nil, fmt.Errorf("duplicate stage %q", name)
+		}
+		if key == "hired" || key == "rejected" {
+			return nil, nil, fmt.Errorf("stage %q is reserved", name)
+		}
+		seen[key] = true
+		appendStage(name, domain.StageKindActive)
+	}
+	appendStage("Hired", domain.StageKindHired)
+	appendStage("Rejected", domain.StageKindRejected)
+
+	removeIDs := make([]uint, 0, len(byKey))
+	for _, s := range byKey {
+		removeIDs = append(removeIDs, s.ID)
+	}
+	return stages, removeIDs, nil
+}
+
+func stageKey(kind domain.StageKind, name string) string {
+	if kind != domain.StageKindActive {
+		return string(kind)
+	}
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func firstStage(stages []domain.PipelineStage) *domain.PipelineStage {
+	for i := range stages {
+		if stages[i].Kind == domain.StageKindActive {
+			return &stages[i]
+		}
+	}
+	return nil
+}
+
+func stageOfKind(stages []domain.PipelineStage, kind domain.StageKind) *domain.PipelineStage {
+	for i := range stages {
+		if stages[i].Kind == kind {
+			return &stages[i]
+		}
+	}
+	return nil
+}
+
+func findStage(stages []domain.PipelineStage, id uint) *domain.PipelineStage {
+	for i := range stages {
+		if stages[i].ID == id {
+			return &stages[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"errors"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+type PipelineUseCase struct {
+	pipelineRepo domain.PipelineRepository
+	jobRepo      domain.JobRepository
+	orgRepo      domain.OrganizationRepository
+	pipelines    pipelines
+	policy       *authz.Policy
+}
+
+func NewPipelineUseCase(pipelineRepo domain.PipelineRepository, jobRepo domain.JobRepository, orgRepo domain.OrganizationRepository, policy *authz.Policy) *PipelineUseCase {
+	return &PipelineUseCase{
+		pipelineRepo: pipelineRepo,
+		jobRepo:      jobRepo,
+		orgRepo:      orgRepo,
+		pipelines:    pipelines{repo: pipelineRepo},
+		policy:       policy,
+	}
+}
+
+func (uc *PipelineUseCase) GetJobPipeline(subject authz.Subject, jobID uint) ([]dto.PipelineStageOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobViewApplications, job); err != nil {
+		return nil, err
+	}
+
+	stages, err := uc.pipelines.forJob(job)
+	if err != nil {
+		return nil, err
+	}
+	return toStageOutputs(stages), nil
+}
+
+// UpdateJobPipeline gives the job its own ordered stages. Applications keep
+// their stage when a stage with the same name exists in the new pipeline;
+// stages that still hold applications cannot be dropped.
+func (uc *PipelineUseCase) UpdateJobPipeline(subject authz.Subject, jobID uint, input dto.UpdatePipelineInputDTO) ([]dto.PipelineStageOutputDTO, error) {
+	job, err := uc.jobRepo.FindByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionJobManagePipeline, job); err != nil {
+		return nil, err
+	}
+	if job.OrganizationID == nil {
+		return nil, errors.New("job does not belong to an organization")
+	}
+
+	current, err := uc.pipelines.forJob(job)
+	if err != nil {
+		return nil, err
+	}
+
+	ownStages := len(current) > 0 && current[0].JobID != nil
+	if ownStages {
+		stages, removeIDs, err := buildStages(*job.OrganizationID, &job.ID, input.Stages, current)
+		if err != nil {
+			return nil, err
+		}
+		if err := uc.pipelineRepo.SaveStages(stages, removeIDs); err != nil {
+			return nil, err
+		}
+		return toStageOutputs(stages), nil
+	}
+
+	// The job is moving off its organization's default pipeline: create its own
+	// stages and carry applications over by stage name.
+	stages, _, err := buildStages(*job.OrganizationID, &job.ID, input.Stages, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]int, len(stages))
+	for i, s := range stages {
+		byKey[stageKey(s.Kind, s.Name)] = i
+	}
+	moves := make([]domain.StageMove, len(current))
+	for i, old := range current {
+		moves[i].From = old
+		if j, ok := byKey[stageKey(old.Kind, old.Name)]; ok {
+			moves[i].To = &stages[j]
+		}
+	}
+
+	if err := uc.pipelineRepo.CreateJobStages(job.ID, stages, moves); err != nil {
+		return nil, err
+	}
+
+	return toStageOutputs(stages), nil
+}
+
+func (uc *PipelineUseCase) GetOrganizationPipeline(subject authz.Subject, orgID uint) ([]dto.PipelineStageOutputDTO, error) {
+	org, err := uc.orgRepo.FindByID(orgID)
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionOrganizationView, org); err != nil {
+		return nil, err
+	}
+
+	stages, err := uc.pipelines.forOrganization(org.ID)
+	if err != nil {
+		return nil, err
+	}
+	return toStageOutputs(stages), nil
+}
+
+func (uc *PipelineUseCase) UpdateOrganizationPipeline(subject authz.Subject, orgID uint, input dto.UpdatePipelineInputDTO) ([]dto.PipelineStageOutputDTO, error) {
+	org, err := uc.orgRepo.FindByID(orgID)
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+	if err := uc.policy.Authorize(subject, authz.ActionOrganizationManage, org); err != nil {
+		return nil, err
+	}
+
+	current, err := uc.pipelines.forOrganization(org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	stages, removeIDs, err := buildStages(org.ID, nil, input.Stages, current)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.pipelineRepo.SaveStages(stages, removeIDs); err != nil {
+		return nil, err
+	}
+	return toStageOutputs(stages), nil
+}
+
+func toStageOutputs(stages []domain.PipelineStage) []dto.PipelineStageOutputDTO {
+	output := make([]dto.PipelineStageOutputDTO, len(stages))
+	for i, s := range stages {
+		output[i] = dto.PipelineStageOutputDTO{
+			ID:       s.ID,
+			Name:     s.Name,
+			Position: s.Position,
+			Kind:     string(s.Kind),
+		}
+	}
+	return output
+}
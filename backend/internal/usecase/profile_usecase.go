@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const (
+	maxHeadlineLength = 120
+	maxSkills         = 50
+	maxProfileLinks   = 10
+	maxProfileEntries = 30
+	profileMonth      = "2006-01"
+)
+
+type ProfileUseCase struct {
+	profileRepo domain.CandidateProfileRepository
+	policy      *authz.Policy
+}
+
+func NewProfileUseCase(profileRepo domain.CandidateProfileRepository, policy *authz.Policy) *ProfileUseCase {
+	return &ProfileUseCase{profileRepo: profileRepo, policy: policy}
+}
+
+func (uc *ProfileUseCase) GetMyProfile(subject authz.Subject) (*dto.CandidateProfileOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionProfileManage, nil); err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.profileRepo.FindByUserID(subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return toProfileOutput(profile), nil
+}
+
+func (uc *ProfileUseCase) CreateMyProfile(subject authz.Subject, input dto.CandidateProfileInputDTO) (*dto.CandidateProfileOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionProfileManage, nil); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.profileRepo.FindByUserID(subject.UserID); err == nil {
+		return nil, errors.New("profile already exists")
+	} else if !errors.Is(err, domain.ErrProfileNotFound) {
+		return nil, err
+	}
+
+	profile := &domain.CandidateProfile{UserID: subject.UserID}
+	if err := applyProfileInput(profile, input); err != nil {
+		return nil, err
+	}
+	if err := uc.profileRepo.Create(profile); err != nil {
+		return nil, err
+	}
+	return toProfileOutput(profile), nil
+}
+
+// UpdateMyProfile replaces the whole profile with input; omitted lists are
+// cleared.
+func (uc *ProfileUseCase) UpdateMyProfile(subject authz.Subject, input dto.CandidateProfileInputDTO) (*dto.CandidateProfileOutputDTO, error) {
+	if err := uc.policy.Authorize(subject, authz.ActionProfileManage, nil); err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.profileRepo.FindByUserID(subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyProfileInput(profile, input); err != nil {
+		return nil, err
+	}
+	if err := uc.profileRepo.Replace(profile); err != nil {
+		return nil, err
+	}
+	return toProfileOutput(profile), nil
+}
+
+func (uc *ProfileUseCase) DeleteMyProfile(subject authz.Subject) error {
+	if err := uc.policy.Authorize(subject, authz.ActionProfileManage, nil); err != nil {
+		return err
+	}
+
+	profile, err := uc.profileRepo.FindByUserID(subject.UserID)
+	if err != nil {
+		return err
+	}
+	return uc.profileRepo.Delete(profile)
+}
+
+func applyProfileInput(profile *domain.CandidateProfile, input dto.CandidateProfileInputDTO) error {
+	headline := strings.TrimSpace(input.Headline)
+	if len([]rune(headline)) > maxHeadlineLength {
+		return fmt.Errorf("headline must be at most %d characters", maxHeadlineLength)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(input.DesiredCurrency))
+	if input.DesiredSalary != nil {
+		if *input.DesiredSalary <= 0 {
+			return errors.New("desired salary must be positive")
+		}
+		if currency == "" {
+			currency = "BRL"
+		}
+	}
+	if currency != "" && !domain.IsCurrencyCode(currency) {
+		return errors.New("desired currency must be a 3-letter ISO 4217 code")
+	}
+
+	skills, err := cleanSkills(input.Skills)
+	if err != nil {
+		return err
+	}
+	links, err := cleanLinks(input.Links)
+	if err != nil {
+		return err
+	}
+
+	if len(input.Experiences) > maxProfileEntries || len(input.Education) > maxProfileEntries {
+		return fmt.Errorf("at most %d experience and %d education entries are allowed", maxProfileEntries, maxProfileEntries)
+	}
+
+	experiences := make([]domain.WorkExperience, len(input.Experiences))
+	for i, e := range input.Experiences {
+		entry := domain.WorkExperience{
+			Position:    i + 1,
+			Title:       strings.TrimSpace(e.Title),
+			Company:     strings.TrimSpace(e.Company),
+			Location:    strings.TrimSpace(e.Location),
+			StartDate:   strings.TrimSpace(e.StartDate),
+			EndDate:     strings.TrimSpace(e.EndDate),
+			Description: strings.TrimSpace(e.Description),
+		}
+		if entry.Title == "" || entry.Company == "" {
+			return fmt.Errorf("experience %d: title and company are required", i+1)
+		}
+		if err := checkPeriod(entry.StartDate, entry.EndDate); err != nil {
+			return fmt.Errorf("experience %d: %w", i+1, err)
+		}
+		experiences[i] = entry
+	}
+
+	education := make([]domain.Education, len(input.Education))
+	for i, e := range input.Education {
+		entry := domain.Education{
+			Position:     i + 1,
+			Institution:  strings.TrimSpace(e.Institution),
+			Degree:       strings.TrimSpace(e.Degree),
+			FieldOfStudy: strings.TrimSpace(e.FieldOfStudy),
+			StartDate:    strings.TrimSpace(e.StartDate),
+			EndDate:      strings.TrimSpace(e.EndDate),
+		}
+		if entry.Institution == "" || entry.Degree == "" {
+			return fmt.Errorf("education %d: institution and degree are required", i+1)
+		}
+		if err := checkPeriod(entry.StartDate, entry.EndDate); err != nil {
+			return fmt.Errorf("education %d: %w", i+1, err)
+		}
+		education[i] = entry
+	}
+
+	profile.Headline = headline
+	profile.Location = strings.TrimSpace(input.Location)
+	profile.DesiredSalary = input.DesiredSalary
+	profile.DesiredCurrency = currency
+	profile.Skills = skills
+	profile.Links = links
+	profile.Experiences = experiences
+	profile.Education = education
+	return nil
+}
+
+// cleanSkills trims skills and drops case-insensitive duplicates, keeping the
+// first spelling the candidate used.
+func cleanSkills(input []string) ([]string, error) {
+	skills := []string{}
+	seen := map[string]bool{}
+	for _, s := range input {
+		s = strings.TrimSpace(s)
+		key := strings.ToLower(s)
+		if s == "" || seen[key] {
+			continue
+		}
+		if len([]rune(s)) > 50 {
+			return nil, fmt.Errorf("skill %q is too long", s)
+		}
+		seen[key] = true
+		skills = append(skills, s)
+	}
+	if len(skills) > maxSkills {
+		return nil, fmt.Errorf("at most %d skills are allowed", maxSkills)
+	}
+	return skills, nil
+}
+
+func cleanLinks(input []dto.ProfileLinkDTO) ([]domain.ProfileLink, error) {
+	if len(input) > maxProfileLinks {
+		return nil, fmt.Errorf("at most %d links are allowed", maxProfileLinks)
+	}
+
+	links := make([]domain.ProfileLink, 0, len(input))
+	for _, l := range input {
+		u, err := url.Parse(strings.TrimSpace(l.URL))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("link %q must be an http or https URL", l.URL)
+		}
+		label := strings.TrimSpace(l.Label)
+		if label == "" {
+			label = u.Host
+		}
+		links = append(links, domain.ProfileLink{Label: label, URL: u.String()})
+	}
+	return links, nil
+}
+
+func checkPeriod(start, end string) error {
+	startAt, err := time.Parse(profileMonth, start)
+	if err != nil {
+		return errors.New("start date must be in YYYY-MM format")
+	}
+	if end == "" {
+		return nil
+	}
+	endAt, err := time.Parse(profileMonth, end)
+	if err != nil {
+		return errors.New("end date must be in YYYY-MM format")
+	}
+	if endAt.Before(startAt) {
+		return errors.New("end date must not be before start date")
+	}
+	return nil
+}
+
+func toProfileOutput(p *domain.CandidateProfile) *dto.CandidateProfileOutputDTO {
+	output := &dto.CandidateProfileOutputDTO{
+		UserID:          p.UserID,
+		Headline:        p.Headline,
+		Location:        p.Location,
+		DesiredSalary:   p.DesiredSalary,
+		DesiredCurrency: p.DesiredCurrency,
+		Skills:          p.Skills,
+		Links:           make([]dto.ProfileLinkDTO, len(p.Links)),
+		Experiences:     make([]dto.WorkExperienceDTO, len(p.Experiences)),
+		Education:       make([]dto.EducationDTO, len(p.Education)),
+		UpdatedAt:       p.UpdatedAt.Format(time.RFC3339),
+	}
+	if output.Skills == nil {
+		output.Skills = []string{}
+	}
+	for i, l := range p.Links {
+		output.Links[i] = dto.ProfileLinkDTO{Label: l.Label, URL: l.URL}
+	}
+	for i, e := range p.Experiences {
+		output.Experiences[i] = dto.WorkExperienceDTO{
+			Title:       e.Title,
+			Company:     e.Company,
+			Location:    e.Location,
+			StartDate:   e.StartDate,
+			EndDate:     e.EndDate,
+			Description: e.Description,
+		}
+	}
+	for i, e := range p.Education {
+		output.Education[i] = dto.EducationDTO{
+			Institution:  e.Institution,
+			Degree:       e.Degree,
+			FieldOfStudy: e.FieldOfStudy,
+			StartDate:    e.StartDate,
+			EndDate:      e.EndDate,
+		}
+	}
+	return output
+}
+
+// toProfileSummary is what recruiters see next to an application.
+func toProfileSummary(p *domain.CandidateProfile, now time.Time) *dto.ProfileSummaryDTO {
+	summary := &dto.ProfileSummaryDTO{
+		Headline:          p.Headline,
+		Location:          p.Location,
+		YearsOfExperience: experienceMonths(p.Experiences, now) / 12,
+		Skills:            p.Skills,
+		DesiredSalary:     p.DesiredSalary,
+		DesiredCurrency:   p.DesiredCurrency,
+	}
+	if summary.Skills == nil {
+		summary.Skills = []string{}
+	}
+	if current := p.CurrentExperience(); current != nil {
+		summary.CurrentTitle = current.Title
+		summary.CurrentCompany = current.Company
+	}
+	return summary
+}
+
+// experienceMonths counts calendar months covered by at least one job, so
+// overlapping positions are not counted twice.
+func experienceMonths(experiences []domain.WorkExperience, now time.Time) int {
+	months := map[int]bool{}
+	for _, e := range experiences {
+		start, err := time.Parse(profileMonth, e.StartDate)
+		if err != nil {
+			continue
+		}
+		end := now
+		if e.EndDate != "" {
+			if end, err = time.Parse(profileMonth, e.EndDate); err != nil {
+				continue
+			}
+		}
+		for m := start.Year()*12 + int(start.Month()); m <= end.Year()*12+int(end.Month()); m++ {
+			months[m] = true
+		}
+	}
+	return len(months)
+}
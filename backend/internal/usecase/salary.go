@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+// applySalary validates input and copies it onto job. A nil input leaves the
+// job without a structured salary.
+func applySalary(job *domain.Job, input *dto.SalaryDTO) error {
+	if input == nil {
+		return nil
+	}
+	if input.Min == nil && input.Max == nil {
+		return errors.New("salary needs a min or a max amount")
+	}
+	if (input.Min != nil && *input.Min <= 0) || (input.Max != nil && *input.Max <= 0) {
+		return errors.New("salary amounts must be positive")
+	}
+	if input.Min != nil && input.Max != nil && *input.Min > *input.Max {
+		return errors.New("salary min cannot be greater than max")
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(input.Currency))
+	if currency == "" {
+		currency = domain.DefaultSalaryCurrency
+	}
+	if !domain.IsCurrencyCode(currency) {
+		return errors.New("salary currency must be a 3-letter ISO 4217 code")
+	}
+
+	period := domain.SalaryPeriod(strings.ToUpper(strings.TrimSpace(input.Period)))
+	if period == "" {
+		period = domain.SalaryPerMonth
+	}
+	if !period.IsValid() {
+		return errors.New("salary period must be HOUR, MONTH or YEAR")
+	}
+
+	job.SalaryMin = input.Min
+	job.SalaryMax = input.Max
+	job.SalaryCurrency = currency
+	job.SalaryPeriod = period
+	job.SalaryHidden = input.Visible != nil && !*input.Visible
+	job.Salary = ""
+	return nil
+}
+
+func toSalaryOutput(job *domain.Job) *dto.SalaryDTO {
+	if job.SalaryMin == nil && job.SalaryMax == nil {
+		return nil
+	}
+	visible := !job.SalaryHidden
+	return &dto.SalaryDTO{
+		Min:      job.SalaryMin,
+		Max:      job.SalaryMax,
+		Currency: job.SalaryCurrency,
+		Period:   string(job.SalaryPeriod),
+		Visible:  &visible,
+	}
+}
@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/helberthlucas14/internal/authz"
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const maxScorecardCriteria = 10
+
+// GetScorecards returns the criteria and scorecards of an application. The
+// other interviewers' scorecards stay hidden until the subject submits their
+// own, so nobody is anchored by earlier ratings.
+func (uc *ApplicationUseCase) GetScorecards(subject authz.Subject, appID uint) (*dto.ApplicationScorecardsOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(appID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationScore, app); err != nil {
+		return nil, err
+	}
+
+	criteria, err := uc.criteriaFor(&app.Job)
+	if err != nil {
+		return nil, err
+	}
+	scorecards, err := uc.scorecardRepo.FindByApplicationIDs([]uint{app.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &dto.ApplicationScorecardsOutputDTO{
+		Criteria:   toCriterionOutputs(criteria),
+		Count:      len(scorecards),
+		Hidden:     true,
+		Scorecards: []dto.ScorecardOutputDTO{},
+	}
+	scores := summarizeScores(scorecards, subject.UserID)[app.ID]
+	if scores == nil || !scores.submitted {
+		return output, nil
+	}
+
+	output.Hidden = false
+	output.Average = scores.average()
+	for i := range scorecards {
+		card := toScorecardOutput(&scorecards[i])
+		if scorecards[i].InterviewerID == subject.UserID {
+			output.Mine = &card
+			continue
+		}
+		output.Scorecards = append(output.Scorecards, card)
+	}
+	return output, nil
+}
+
+// SubmitScorecard records the subject's evaluation of an application, rating
+// every criterion of the job's scorecard. Submitting again replaces it.
+func (uc *ApplicationUseCase) SubmitScorecard(subject authz.Subject, input dto.SubmitScorecardInputDTO) (*dto.ScorecardOutputDTO, error) {
+	app, err := uc.appRepo.FindByID(input.ApplicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if err := uc.policy.Authorize(subject, authz.ActionApplicationScore, app); err != nil {
+		return nil, err
+	}
+
+	if app.Status == domain.StatusCanceled {
+		return nil, errors.New("canceled applications cannot be scored")
+	}
+
+	criteria, err := uc.criteriaFor(&app.Job)
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := rateCriteria(criteria, input.Ratings)
+	if err != nil {
+		return nil, err
+	}
+
+	scorecards, err := uc.scorecardRepo.FindByApplicationIDs([]uint{app.ID})
+	if err != nil {
+		return nil, err
+	}
+	scorecard := &domain.Scorecard{ApplicationID: app.ID, InterviewerID: subject.UserID}
+	for i := range scorecards {
+		if scorecards[i].InterviewerID == subject.UserID {
+			scorecard = &scorecards[i]
+		}
+	}
+
+	scorecard.Ratings = ratings
+	scorecard.Average = averageRating(ratings)
+	scorecard.Comment = strings.TrimSpace(input.Comment)
+	if err := uc.scorecardRepo.Save(scorecard); err != nil {
+		return nil, err
+	}
+
+	output := toScorecardOutput(scorecard)
+	return &output, nil
+}
+
+// criteriaFor returns the scorecard criteria of job, giving jobs created
+// before scorecards existed the default ones.
+func (uc *ApplicationUseCase) criteriaFor(job *domain.Job) ([]domain.ScorecardCriterion, error) {
+	if len(job.Criteria) > 0 {
+		return job.Criteria, nil
+	}
+
+	criteria, err := buildCriteria(domain.DefaultScorecardCriteria, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.jobRepo.ReplaceCriteria(job.ID, criteria); err != nil {
+		return nil, err
+	}
+	job.Criteria = criteria
+	return criteria, nil
+}
+
+// buildCriteria turns an ordered list of criterion names into rows. Rows in
+// existing with the same name are reused so they keep their ratings.
+func buildCriteria(names []string, existing []domain.ScorecardCriterion) ([]domain.ScorecardCriterion, error) {
+	if len(names) == 0 {
+		return nil, errors.New("a scorecard needs at least one criterion")
+	}
+	if len(names) > maxScorecardCriteria {
+		return nil, fmt.Errorf("a scorecard can have at most %d criteria", maxScorecardCriteria)
+	}
+
+	byName := make(map[string]domain.ScorecardCriterion, len(existing))
+	for _, c := range existing {
+		byName[strings.ToLower(c.Name)] = c
+	}
+
+	criteria := make([]domain.ScorecardCriterion, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, raw := range names {
+		name := strings.TrimSpace(raw)
+		if name == "" {
+			return nil, errors.New("criterion names cannot be empty")
+		}
+		key := strings.ToLower(name)
+		if seen[key] {
+			return nil, fmt.Errorf("criterion %q is listed twice", name)
+		}
+		seen[key] = true
+
+		criterion := byName[key]
+		criterion.Name = name
+		criterion.Position = len(criteria) + 1
+		criteria = append(criteria, criterion)
+	}
+	return criteria, nil
+}
+
+// rateCriteria checks that every criterion is rated exactly once within the
+// allowed range.
+func rateCriteria(criteria []domain.ScorecardCriterion, input []dto.RatingInputDTO) ([]domain.ScorecardRating, error) {
+	scores := make(map[uint]int, len(input))
+	for _, r := range input {
+		if findCriterion(criteria, r.CriterionID) == nil {
+			return nil, fmt.Errorf("criterion %d is not on this job's scorecard", r.CriterionID)
+		}
+		if _, ok := scores[r.CriterionID]; ok {
+			return nil, fmt.Errorf("criterion %d is rated twice", r.CriterionID)
+		}
+		if r.Score < domain.MinScore || r.Score > domain.MaxScore {
+			return nil, fmt.Errorf("scores must be between %d and %d", domain.MinScore, domain.MaxScore)
+		}
+		scores[r.CriterionID] = r.Score
+	}
+
+	ratings := make([]domain.ScorecardRating, len(criteria))
+	for i, c := range criteria {
+		score, ok := scores[c.ID]
+		if !ok {
+			return nil, fmt.Errorf("criterion %q must be rated", c.Name)
+		}
+		ratings[i] = domain.ScorecardRating{CriterionID: c.ID, Criterion: c.Name, Score: score}
+	}
+	return ratings, nil
+}
+
+func findCriterion(criteria []domain.ScorecardCriterion, id uint) *domain.ScorecardCriterion {
+	for i := range criteria {
+		if criteria[i].ID == id {
+			return &criteria[i]
+		}
+	}
+	return nil
+}
+
+func averageRating(ratings []domain.ScorecardRating) float64 {
+	if len(ratings) == 0 {
+		return 0
+	}
+	total := 0
+	for _, r := range ratings {
+		total += r.Score
+	}
+	return float64(total) / float64(len(ratings))
+}
+
+// scoreSummary aggregates the scorecards of one application as seen by one
+// interviewer.
+type scoreSummary struct {
+	count     int
+	total     float64
+	submitted bool
+}
+
+// average is the mean of the scorecard averages, rounded to two decimals.
+func (s *scoreSummary) average() *float64 {
+	if s.count == 0 {
+		return nil
+	}
+	avg := math.Round(s.total/float64(s.count)*100) / 100
+	return &avg
+}
+
+func summarizeScores(scorecards []domain.Scorecard, userID uint) map[uint]*scoreSummary {
+	summaries := make(map[uint]*scoreSummary)
+	for _, s := range scorecards {
+		summary := summaries[s.ApplicationID]
+		if summary == nil {
+			summary = &scoreSummary{}
+			summaries[s.ApplicationID] = summary
+		}
+		summary.count++
+		summary.total += s.Average
+		if s.InterviewerID == userID {
+			summary.submitted = true
+		}
+	}
+	return summaries
+}
+
+func toCriterionOutputs(criteria []domain.ScorecardCriterion) []dto.ScorecardCriterionOutputDTO {
+	output := make([]dto.ScorecardCriterionOutputDTO, len(criteria))
+	for i, c := range criteria {
+		output[i] = dto.ScorecardCriterionOutputDTO{ID: c.ID, Name: c.Name}
+	}
+	return output
+}
+
+func toScorecardOutput(s *domain.Scorecard) dto.ScorecardOutputDTO {
+	output := dto.ScorecardOutputDTO{
+		ID:              s.ID,
+		ApplicationID:   s.ApplicationID,
+		InterviewerID:   s.InterviewerID,
+		InterviewerName: s.Interviewer.Name,
+		Ratings:         make([]dto.RatingOutputDTO, len(s.Ratings)),
+		Average:         math.Round(s.Average*100) / 100,
+		Comment:         s.Comment,
+		SubmittedAt:     s.UpdatedAt.Format(time.RFC3339),
+	}
+	for i, r := range s.Ratings {
+		output.Ratings[i] = dto.RatingOutputDTO{CriterionID: r.CriterionID, Criterion: r.Criterion, Score: r.Score}
+	}
+	return output
+}
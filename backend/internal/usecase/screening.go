@@ -0,0 +1,295 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/helberthlucas14/internal/domain"
+	"github.com/helberthlucas14/internal/dto"
+)
+
+const (
+	maxScreeningQuestions = 20
+	maxQuestionOptions    = 20
+	maxPromptLength       = 300
+	maxTextAnswerLength   = 2000
+)
+
+// buildQuestions validates question definitions sent by a recruiter.
+func buildQuestions(input []dto.ScreeningQuestionInputDTO) ([]domain.ScreeningQuestion, error) {
+	if len(input) > maxScreeningQuestions {
+		return nil, fmt.Errorf("a job can have at most %d screening questions", maxScreeningQuestions)
+	}
+
+	questions := make([]domain.ScreeningQuestion, len(input))
+	for i, in := range input {
+		q := domain.ScreeningQuestion{
+			Position: i + 1,
+			Prompt:   strings.TrimSpace(in.Prompt),
+			Type:     domain.QuestionType(strings.ToUpper(strings.TrimSpace(in.Type))),
+			Required: in.Required,
+		}
+		if q.Prompt == "" || len([]rune(q.Prompt)) > maxPromptLength {
+			return nil, fmt.Errorf("question %d: prompt is required and must be at most %d characters", i+1, maxPromptLength)
+		}
+
+		switch q.Type {
+		case domain.QuestionYesNo, domain.QuestionNumber, domain.QuestionText:
+			if len(in.Options) > 0 {
+				return nil, fmt.Errorf("question %d: options are only allowed for choice questions", i+1)
+			}
+		case domain.QuestionSingleChoice, domain.QuestionMultipleChoice:
+			options, err := cleanOptions(in.Options)
+			if err != nil {
+				return nil, fmt.Errorf("question %d: %w", i+1, err)
+			}
+			q.Options = options
+		default:
+			return nil, fmt.Errorf("question %d: type must be YES_NO, NUMBER, SINGLE_CHOICE, MULTIPLE_CHOICE or TEXT", i+1)
+		}
+
+		if in.Knockout != nil {
+			rule, err := buildKnockout(q, in.Knockout)
+			if err != nil {
+				return nil, fmt.Errorf("question %d: %w", i+1, err)
+			}
+			q.Knockout = rule
+		}
+		questions[i] = q
+	}
+	return questions, nil
+}
+
+func cleanOptions(input []string) ([]string, error) {
+	options := []string{}
+	seen := map[string]bool{}
+	for _, o := range input {
+		o = strings.TrimSpace(o)
+		if o == "" {
+			continue
+		}
+		if seen[strings.ToLower(o)] {
+			return nil, fmt.Errorf("option %q is repeated", o)
+		}
+		seen[strings.ToLower(o)] = true
+		options = append(options, o)
+	}
+	if len(options) < 2 || len(options) > maxQuestionOptions {
+		return nil, fmt.Errorf("choice questions need between 2 and %d options", maxQuestionOptions)
+	}
+	return options, nil
+}
+
+func buildKnockout(q domain.ScreeningQuestion, in *dto.KnockoutRuleDTO) (*domain.KnockoutRule, error) {
+	rule := &domain.KnockoutRule{Action: domain.KnockoutAction(strings.ToUpper(strings.TrimSpace(in.Action)))}
+	if rule.Action != domain.KnockoutReject && rule.Action != domain.KnockoutFlag {
+		return nil, errors.New("knockout action must be REJECT or FLAG")
+	}
+
+	switch q.Type {
+	case domain.QuestionYesNo:
+		if in.Expected == nil {
+			return nil, errors.New("yes/no knockout rules need an expected answer")
+		}
+		rule.Expected = in.Expected
+	case domain.QuestionNumber:
+		if in.Min == nil && in.Max == nil {
+			return nil, errors.New("number knockout rules need a min or max")
+		}
+		if in.Min != nil && in.Max != nil && *in.Min > *in.Max {
+			return nil, errors.New("knockout min must not exceed max")
+		}
+		rule.Min, rule.Max = in.Min, in.Max
+	case domain.QuestionSingleChoice, domain.QuestionMultipleChoice:
+		for _, a := range in.Accepted {
+			option, ok := matchOption(q.Options, a)
+			if !ok {
+				return nil, fmt.Errorf("accepted answer %q is not one of the options", a)
+			}
+			rule.Accepted = append(rule.Accepted, option)
+		}
+		if len(rule.Accepted) == 0 {
+			return nil, errors.New("choice knockout rules need at least one accepted option")
+		}
+	default:
+		return nil, errors.New("text questions cannot have knockout rules")
+	}
+	return rule, nil
+}
+
+// screeningResult is the outcome of checking a candidate's answers.
+type screeningResult struct {
+	answers  []domain.ScreeningAnswer
+	rejectBy *domain.ScreeningQuestion
+	flagged  bool
+}
+
+// answerQuestions validates and normalizes answers against the job's questions
+// and applies their knockout rules.
+func answerQuestions(questions []domain.ScreeningQuestion, input []dto.ScreeningAnswerInputDTO) (*screeningResult, error) {
+	known := make(map[uint]bool, len(questions))
+	for _, q := range questions {
+		known[q.ID] = true
+	}
+
+	byID := make(map[uint]dto.ScreeningAnswerInputDTO, len(input))
+	for _, a := range input {
+		if !known[a.QuestionID] {
+			return nil, fmt.Errorf("question %d does not belong to this job", a.QuestionID)
+		}
+		if _, dup := byID[a.QuestionID]; dup {
+			return nil, fmt.Errorf("question %d is answered more than once", a.QuestionID)
+		}
+		byID[a.QuestionID] = a
+	}
+
+	result := &screeningResult{}
+	for i := range questions {
+		q := &questions[i]
+		values, err := normalizeAnswer(q, byID[q.ID])
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", q.Prompt, err)
+		}
+		if len(values) == 0 {
+			if q.Required {
+				return nil, fmt.Errorf("%q: an answer is required", q.Prompt)
+			}
+			continue
+		}
+
+		answer := domain.ScreeningAnswer{
+			QuestionID: q.ID,
+			Position:   q.Position,
+			Prompt:     q.Prompt,
+			Type:       q.Type,
+			Values:     values,
+		}
+		if q.KnocksOut(values) {
+			answer.KnockedOut = true
+			answer.Action = q.Knockout.Action
+			if q.Knockout.Action == domain.KnockoutReject && result.rejectBy == nil {
+				result.rejectBy = q
+			}
+			if q.Knockout.Action == domain.KnockoutFlag {
+				result.flagged = true
+			}
+		}
+		result.answers = append(result.answers, answer)
+	}
+	return result, nil
+}
+
+func normalizeAnswer(q *domain.ScreeningQuestion, in dto.ScreeningAnswerInputDTO) ([]string, error) {
+	value := strings.TrimSpace(in.Value)
+
+	switch q.Type {
+	case domain.QuestionMultipleChoice:
+		values := []string{}
+		seen := map[string]bool{}
+		for _, v := range in.Values {
+			option, ok := matchOption(q.Options, v)
+			if !ok {
+				return nil, fmt.Errorf("%q is not one of the options", v)
+			}
+			if !seen[option] {
+				seen[option] = true
+				values = append(values, option)
+			}
+		}
+		return values, nil
+	case domain.QuestionYesNo:
+		switch strings.ToLower(value) {
+		case "":
+			return nil, nil
+		case "yes", "true", "sim":
+			return []string{"yes"}, nil
+		case "no", "false", "não", "nao":
+			return []string{"no"}, nil
+		}
+		return nil, errors.New("answer must be yes or no")
+	case domain.QuestionNumber:
+		if value == "" {
+			return nil, nil
+		}
+		n, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", "."), 64)
+		if err != nil {
+			return nil, errors.New("answer must be a number")
+		}
+		return []string{strconv.FormatFloat(n, 'f', -1, 64)}, nil
+	case domain.QuestionSingleChoice:
+		if value == "" {
+			return nil, nil
+		}
+		option, ok := matchOption(q.Options, value)
+		if !ok {
+			return nil, fmt.Errorf("%q is not one of the options", value)
+		}
+		return []string{option}, nil
+	default:
+		if value == "" {
+			return nil, nil
+		}
+		if len([]rune(value)) > maxTextAnswerLength {
+			return nil, fmt.Errorf("answer must be at most %d characters", maxTextAnswerLength)
+		}
+		return []string{value}, nil
+	}
+}
+
+// matchOption finds value among options ignoring case and returns the
+// option's own spelling.
+func matchOption(options []string, value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	for _, o := range options {
+		if strings.EqualFold(o, value) {
+			return o, true
+		}
+	}
+	return "", false
+}
+
+func toQuestionOutputs(questions []domain.ScreeningQuestion, withRules bool) []dto.ScreeningQuestionOutputDTO {
+	if len(questions) == 0 {
+		return nil
+	}
+	output := make([]dto.ScreeningQuestionOutputDTO, len(questions))
+	for i, q := range questions {
+		output[i] = dto.ScreeningQuestionOutputDTO{
+			ID:       q.ID,
+			Prompt:   q.Prompt,
+			Type:     string(q.Type),
+			Required: q.Required,
+			Options:  q.Options,
+		}
+		if withRules && q.Knockout != nil {
+			output[i].Knockout = &dto.KnockoutRuleDTO{
+				Action:   string(q.Knockout.Action),
+				Expected: q.Knockout.Expected,
+				Min:      q.Knockout.Min,
+				Max:      q.Knockout.Max,
+				Accepted: q.Knockout.Accepted,
+			}
+		}
+	}
+	return output
+}
+
+func toAnswerOutputs(answers []domain.ScreeningAnswer) []dto.ScreeningAnswerOutputDTO {
+	if len(answers) == 0 {
+		return nil
+	}
+	output := make([]dto.ScreeningAnswerOutputDTO, len(answers))
+	for i, a := range answers {
+		output[i] = dto.ScreeningAnswerOutputDTO{
+			QuestionID: a.QuestionID,
+			Prompt:     a.Prompt,
+			Type:       string(a.Type),
+			Values:     a.Values,
+			KnockedOut: a.KnockedOut,
+			Action:     string(a.Action),
+		}
+	}
+	return output
+}
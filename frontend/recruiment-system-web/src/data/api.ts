@@ -1,4 +1,4 @@
-import axios from 'axios';
+import axios, { type AxiosRequestConfig } from 'axios';
 
 const baseURL = (typeof import.meta !== 'undefined' && import.meta.env && import.meta.env.VITE_API_URL) || 'http://localhost:8081';
 const api = axios.create({
@@ -13,4 +13,42 @@ api.interceptors.request.use((config) => {
     return config;
 });
 
+let refreshing: Promise<string | null> | null = null;
+
+const refreshAccessToken = async (): Promise<string | null> => {
+    const refreshToken = localStorage.getItem('refresh_token');
+    if (!refreshToken) {
+        return null;
+    }
+    try {
+        const response = await axios.post(`${baseURL}/token/refresh`, { refresh_token: refreshToken });
+        localStorage.setItem('token', response.data.token);
+        localStorage.setItem('refresh_token', response.data.refresh_token);
+        return response.data.token;
+    } catch {
+        localStorage.removeItem('token');
+        localStorage.removeItem('refresh_token');
+        localStorage.removeItem('user');
+        return null;
+    }
+};
+
+api.interceptors.response.use(
+    (response) => response,
+    async (error) => {
+        const original = error.config as AxiosRequestConfig & { _retry?: boolean };
+        if (error.response?.status !== 401 || !original || original._retry) {
+            return Promise.reject(error);
+        }
+
+        original._retry = true;
+        refreshing = refreshing ?? refreshAccessToken().finally(() => { refreshing = null; });
+        const token = await refreshing;
+        if (!token) {
+            return Promise.reject(error);
+        }
+        return api(original);
+    }
+);
+
 export default api;
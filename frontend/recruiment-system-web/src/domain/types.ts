@@ -1,6 +1,7 @@
 export const Role = {
   CANDIDATE: 'CANDIDATE',
   RECRUITER: 'RECRUITER',
+  ADMIN: 'ADMIN',
 } as const;
 
 export type Role = (typeof Role)[keyof typeof Role];
@@ -18,13 +19,90 @@ export interface Job {
   description: string;
   company: string;
   location: string;
+  latitude?: number;
+  longitude?: number;
+  distance_km?: number;
   requirements?: string;
-  salary?: string;
-  status: 'OPEN' | 'CLOSED';
+  category?: Category;
+  employment_type?: EmploymentType;
+  seniority?: Seniority;
+  work_model?: WorkModel;
+  salary?: Salary;
+  salary_text?: string;
+  status: 'DRAFT' | 'PENDING_APPROVAL' | 'SCHEDULED' | 'OPEN' | 'PAUSED' | 'CLOSED' | 'EXPIRED';
+  openings?: number;
+  publish_at?: string;
+  expires_at?: string;
+  archived_at?: string;
+  deleted_at?: string;
   created_at?: string;
   recruiter_id?: number;
+  organization_id?: number;
   recruiter_email?: string;
   anonymous?: boolean;
+  highlights?: JobHighlights;
+  questions?: ScreeningQuestion[];
+  criteria?: ScorecardCriterion[];
+}
+
+export interface JobApproval {
+  id: number;
+  job_id: number;
+  job_title: string;
+  organization_id: number;
+  status: 'PENDING' | 'APPROVED' | 'REJECTED';
+  requested_by_id: number;
+  requested_by_name?: string;
+  comment: string;
+  decided_by_id?: number;
+  decided_by_name?: string;
+  decision_comment?: string;
+  decided_at?: string;
+  created_at: string;
+}
+
+export interface JobHighlights {
+  title: string;
+  description: string;
+}
+
+export interface Category {
+  id: number;
+  slug: string;
+  name: string;
+}
+
+export type EmploymentType = 'CLT' | 'PJ' | 'CONTRACT' | 'INTERNSHIP';
+export type Seniority = 'INTERN' | 'JUNIOR' | 'MID' | 'SENIOR' | 'LEAD';
+export type WorkModel = 'REMOTE' | 'HYBRID' | 'ONSITE';
+
+export type SalaryPeriod = 'HOUR' | 'MONTH' | 'YEAR';
+
+export interface Salary {
+  min?: number;
+  max?: number;
+  currency: string;
+  period: SalaryPeriod;
+  visible?: boolean;
+}
+
+export type QuestionType = 'YES_NO' | 'NUMBER' | 'SINGLE_CHOICE' | 'MULTIPLE_CHOICE' | 'TEXT';
+
+export interface ScreeningQuestion {
+  id: number;
+  prompt: string;
+  type: QuestionType;
+  required: boolean;
+  options?: string[];
+}
+
+export interface ScreeningAnswer {
+  question_id: number;
+  prompt: string;
+  type: QuestionType;
+  values: string[];
+  knocked_out: boolean;
+  action?: 'REJECT' | 'FLAG';
 }
 
 export interface Application {
@@ -38,6 +116,151 @@ export interface Application {
   company: string;
   location: string;
   applied_at: string;
+  flagged?: boolean;
+  answers?: ScreeningAnswer[];
+  attachments?: Attachment[];
+  profile?: ProfileSummary;
+  notes?: ApplicationNote[];
+  scorecard_count?: number;
+  average_score?: number;
+}
+
+export interface ScorecardCriterion {
+  id: number;
+  name: string;
+}
+
+export interface Scorecard {
+  id: number;
+  application_id: number;
+  interviewer_id: number;
+  interviewer_name?: string;
+  ratings: { criterion_id: number; criterion: string; score: number }[];
+  average: number;
+  comment?: string;
+  submitted_at: string;
+}
+
+export interface ApplicationScorecards {
+  criteria: ScorecardCriterion[];
+  count: number;
+  average?: number;
+  hidden: boolean;
+  mine?: Scorecard;
+  scorecards: Scorecard[];
+}
+
+export type InterviewStatus = 'SCHEDULED' | 'DONE' | 'NO_SHOW' | 'CANCELED';
+
+export interface Interview {
+  id: number;
+  application_id: number;
+  job_id: number;
+  job_title: string;
+  candidate_id: number;
+  candidate_name: string;
+  uid: string;
+  sequence: number;
+  starts_at: string;
+  ends_at: string;
+  location?: string;
+  video_url?: string;
+  status: InterviewStatus;
+  interviewers: { user_id: number; name: string }[];
+  scheduled_by_id: number;
+  scheduled_by_name: string;
+  cancel_reason?: string;
+  created_at: string;
+}
+
+export interface InterviewSlot {
+  id: number;
+  job_id: number;
+  stage_id?: number;
+  interviewer_id: number;
+  interviewer_name: string;
+  starts_at: string;
+  ends_at: string;
+  location?: string;
+  video_url?: string;
+  booked: boolean;
+  interview_id?: number;
+  candidate_name?: string;
+}
+
+export type OfferStatus = 'DRAFT' | 'SENT' | 'ACCEPTED' | 'DECLINED' | 'EXPIRED' | 'WITHDRAWN';
+
+export interface Offer {
+  id: number;
+  application_id: number;
+  job_id: number;
+  job_title: string;
+  candidate_id: number;
+  candidate_name: string;
+  salary: number;
+  currency: string;
+  period: SalaryPeriod;
+  start_date: string;
+  benefits?: string;
+  expires_at: string;
+  status: OfferStatus;
+  created_by_id: number;
+  created_by_name: string;
+  sent_at?: string;
+  responded_at?: string;
+  decline_reason?: string;
+  created_at: string;
+}
+
+export interface PipelineStage {
+  id: number;
+  name: string;
+  position: number;
+  kind: 'ACTIVE' | 'HIRED' | 'REJECTED';
+}
+
+export interface ApplicationNote {
+  id: number;
+  application_id: number;
+  parent_id?: number;
+  author_id: number;
+  author_name: string;
+  body: string;
+  private: boolean;
+  mentions: { user_id: number; name: string }[];
+  revisions?: { body: string; edited_at: string }[];
+  replies?: ApplicationNote[];
+  edited_at?: string;
+  created_at: string;
+}
+
+export interface OrganizationMember {
+  user_id: number;
+  name: string;
+  email: string;
+  role: string;
+  joined_at: string;
+}
+
+export interface ProfileSummary {
+  headline: string;
+  location: string;
+  current_title?: string;
+  current_company?: string;
+  years_of_experience: number;
+  skills: string[];
+  desired_salary?: number;
+  desired_currency?: string;
+}
+
+export interface Attachment {
+  id: number;
+  kind: 'RESUME' | 'COVER_LETTER';
+  filename: string;
+  content_type: string;
+  size: number;
+  url?: string;
+  expires_at?: string;
 }
 
 export interface PaginationMeta {
@@ -52,6 +275,27 @@ export interface PaginatedResponse<T> {
   meta: PaginationMeta;
 }
 
+export interface FacetCount {
+  value: string;
+  count: number;
+}
+
+export interface JobFacets {
+  location: FacetCount[];
+  company: FacetCount[];
+  employment_type: FacetCount[];
+  seniority: FacetCount[];
+  work_model: FacetCount[];
+  salary_band: FacetCount[];
+  posted_within: FacetCount[];
+}
+
+export type JobFacetSelection = Partial<Record<keyof JobFacets, string[]>>;
+
+export interface PaginatedJobs extends PaginatedResponse<Job> {
+  facets?: JobFacets;
+}
+
 // Input Types
 export interface RegisterInput {
   name: string;
@@ -67,6 +311,8 @@ export interface LoginInput {
 
 export interface LoginResponse {
   token: string;
+  refresh_token: string;
+  expires_in: number;
 }
 
 export interface CreateJobInput {
@@ -75,13 +321,20 @@ export interface CreateJobInput {
   company: string;
   location: string;
   requirements?: string;
-  salary?: string;
+  category_id?: number;
+  employment_type?: EmploymentType;
+  seniority?: Seniority;
+  work_model?: WorkModel;
+  salary?: Salary;
 }
 
 export interface PaginationInput {
   page?: number;
   limit?: number;
   q?: string;
+  salary_min?: number;
+  salary_max?: number;
+  currency?: string;
 }
 
 export interface DashboardStats {
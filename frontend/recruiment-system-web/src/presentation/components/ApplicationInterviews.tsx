@@ -0,0 +1,240 @@
+import React, { useState } from 'react';
+import { Box, Button, Chip, MenuItem, TextField, Typography } from '@mui/material';
+import api from '../../shared/lib/api';
+import type { Interview, InterviewSlot, InterviewStatus, OrganizationMember } from '../../domain/types';
+import { useToast } from '../context/toastBase';
+
+type Props = {
+  applicationId: number;
+  // Hiring team of the job. Without it the list is read-only, as the
+  // candidate sees it.
+  team?: OrganizationMember[];
+};
+
+const statusLabels: Record<InterviewStatus, string> = {
+  SCHEDULED: 'Agendada',
+  DONE: 'Realizada',
+  NO_SHOW: 'Não compareceu',
+  CANCELED: 'Cancelada',
+};
+
+// datetime-local inputs work in local time without a zone.
+const toLocalInput = (iso: string) => {
+  const d = new Date(iso);
+  return new Date(d.getTime() - d.getTimezoneOffset() * 60000).toISOString().slice(0, 16);
+};
+
+const errorMessage = (err: unknown, fallback: string) =>
+  (err as { response?: { data?: { error?: string } } }).response?.data?.error || fallback;
+
+const ApplicationInterviews: React.FC<Props> = ({ applicationId, team }) => {
+  const [interviews, setInterviews] = useState<Interview[] | null>(null);
+  const [editing, setEditing] = useState<number | 'new' | null>(null);
+  const [startsAt, setStartsAt] = useState('');
+  const [endsAt, setEndsAt] = useState('');
+  const [location, setLocation] = useState('');
+  const [videoURL, setVideoURL] = useState('');
+  const [interviewerIds, setInterviewerIds] = useState<number[]>([]);
+  const [openSlots, setOpenSlots] = useState<InterviewSlot[] | null>(null);
+  const { showToast } = useToast();
+  const canManage = !!team;
+
+  const load = async () => {
+    try {
+      const res = await api.get<Interview[]>(`/applications/${applicationId}/interviews`);
+      setInterviews(res.data || []);
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao carregar as entrevistas'), severity: 'error' });
+    }
+  };
+
+  const openForm = (interview?: Interview) => {
+    setEditing(interview ? interview.id : 'new');
+    setStartsAt(interview ? toLocalInput(interview.starts_at) : '');
+    setEndsAt(interview ? toLocalInput(interview.ends_at) : '');
+    setLocation(interview?.location || '');
+    setVideoURL(interview?.video_url || '');
+    setInterviewerIds(interview ? interview.interviewers.map((i) => i.user_id) : []);
+  };
+
+  const handleSave = async () => {
+    const payload = {
+      starts_at: new Date(startsAt).toISOString(),
+      ends_at: new Date(endsAt).toISOString(),
+      interviewer_ids: interviewerIds,
+      location,
+      video_url: videoURL,
+    };
+    try {
+      if (editing === 'new') {
+        await api.post(`/applications/${applicationId}/interviews`, payload);
+        showToast({ message: 'Entrevista agendada e convites enviados', severity: 'success' });
+      } else {
+        await api.post(`/applications/${applicationId}/interviews/${editing}/reschedule`, payload);
+        showToast({ message: 'Entrevista remarcada', severity: 'success' });
+      }
+      setEditing(null);
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao salvar a entrevista'), severity: 'error' });
+    }
+  };
+
+  const handleCancel = async (interview: Interview) => {
+    const reason = window.prompt('Motivo do cancelamento (opcional)');
+    if (reason === null) return;
+    try {
+      await api.post(`/applications/${applicationId}/interviews/${interview.id}/cancel`, { reason });
+      showToast({ message: 'Entrevista cancelada', severity: 'success' });
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao cancelar a entrevista'), severity: 'error' });
+    }
+  };
+
+  const handleOutcome = async (interview: Interview, status: InterviewStatus) => {
+    try {
+      await api.post(`/applications/${applicationId}/interviews/${interview.id}/outcome`, { status });
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao registrar o resultado'), severity: 'error' });
+    }
+  };
+
+  // Candidates book one of the slots the hiring team published.
+  const loadSlots = async () => {
+    try {
+      const res = await api.get<InterviewSlot[]>(`/applications/${applicationId}/interview-slots`);
+      setOpenSlots(res.data || []);
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao carregar os horários'), severity: 'error' });
+    }
+  };
+
+  const handleBook = async (slot: InterviewSlot) => {
+    try {
+      await api.post(`/applications/${applicationId}/interview-slots`, { slot_id: slot.id });
+      showToast({ message: 'Entrevista reservada, o convite foi enviado por e-mail', severity: 'success' });
+      setOpenSlots(null);
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao reservar o horário'), severity: 'error' });
+      await loadSlots();
+    }
+  };
+
+  const handleDownload = async (interview: Interview) => {
+    try {
+      const res = await api.get<Blob>(`/applications/${applicationId}/interviews/${interview.id}/calendar`, { responseType: 'blob' });
+      const url = URL.createObjectURL(res.data);
+      const link = document.createElement('a');
+      link.href = url;
+      link.download = 'interview.ics';
+      link.click();
+      URL.revokeObjectURL(url);
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao baixar o convite'), severity: 'error' });
+    }
+  };
+
+  if (!interviews) {
+    return <Button size="small" onClick={load}>Entrevistas</Button>;
+  }
+
+  const started = (interview: Interview) => new Date(interview.starts_at) <= new Date();
+
+  return (
+    <Box mt={1} p={1} border={1} borderColor="divider" borderRadius={1}>
+      <Typography variant="subtitle2">Entrevistas</Typography>
+      {interviews.length === 0 && (
+        <Typography variant="caption" color="text.secondary">Nenhuma entrevista agendada.</Typography>
+      )}
+      {interviews.map((interview) => (
+        <Box key={interview.id} py={0.5}>
+          <Box display="flex" alignItems="center" gap={1}>
+            <Typography variant="body2">
+              {new Date(interview.starts_at).toLocaleString()} – {new Date(interview.ends_at).toLocaleTimeString()}
+            </Typography>
+            <Chip size="small" variant="outlined" label={statusLabels[interview.status]} />
+          </Box>
+          <Typography variant="caption" color="text.secondary" display="block">
+            {interview.interviewers.map((i) => i.name).join(', ')}
+            {interview.location && ` • ${interview.location}`}
+          </Typography>
+          {interview.video_url && (
+            <Button size="small" href={interview.video_url} target="_blank" rel="noopener noreferrer">Videochamada</Button>
+          )}
+          {interview.cancel_reason && <Typography variant="caption" display="block">Motivo: {interview.cancel_reason}</Typography>}
+          <Box display="flex" gap={1}>
+            <Button size="small" onClick={() => handleDownload(interview)}>Adicionar à agenda</Button>
+            {canManage && interview.status === 'SCHEDULED' && (
+              <>
+                <Button size="small" onClick={() => openForm(interview)}>Remarcar</Button>
+                <Button size="small" color="error" onClick={() => handleCancel(interview)}>Cancelar</Button>
+                {started(interview) && (
+                  <>
+                    <Button size="small" onClick={() => handleOutcome(interview, 'DONE')}>Realizada</Button>
+                    <Button size="small" onClick={() => handleOutcome(interview, 'NO_SHOW')}>Não compareceu</Button>
+                  </>
+                )}
+              </>
+            )}
+          </Box>
+        </Box>
+      ))}
+      {canManage && editing !== null && (
+        <Box display="flex" flexDirection="column" gap={1} mt={1}>
+          <Box display="flex" gap={1}>
+            <TextField size="small" type="datetime-local" label="Início" InputLabelProps={{ shrink: true }} value={startsAt} onChange={(e) => setStartsAt(e.target.value)} />
+            <TextField size="small" type="datetime-local" label="Fim" InputLabelProps={{ shrink: true }} value={endsAt} onChange={(e) => setEndsAt(e.target.value)} />
+          </Box>
+          <TextField
+            select
+            size="small"
+            label="Entrevistadores"
+            SelectProps={{ multiple: true }}
+            value={interviewerIds}
+            onChange={(e) => setInterviewerIds(e.target.value as unknown as number[])}
+            helperText="Sem seleção, você será o entrevistador"
+          >
+            {(team || []).map((m) => (
+              <MenuItem key={m.user_id} value={m.user_id}>{m.name}</MenuItem>
+            ))}
+          </TextField>
+          <TextField size="small" label="Local" value={location} onChange={(e) => setLocation(e.target.value)} />
+          <TextField size="small" label="Link da videochamada" value={videoURL} onChange={(e) => setVideoURL(e.target.value)} />
+          <Box display="flex" gap={1}>
+            <Button size="small" variant="contained" onClick={handleSave} disabled={!startsAt || !endsAt}>
+              {editing === 'new' ? 'Agendar e enviar convites' : 'Remarcar e enviar atualização'}
+            </Button>
+            <Button size="small" onClick={() => setEditing(null)}>Voltar</Button>
+          </Box>
+        </Box>
+      )}
+      {!canManage && openSlots && (
+        <Box mt={1}>
+          {openSlots.length === 0 && (
+            <Typography variant="caption" color="text.secondary">Nenhum horário disponível no momento.</Typography>
+          )}
+          {openSlots.map((slot) => (
+            <Box key={slot.id} display="flex" alignItems="center" gap={1}>
+              <Typography variant="body2">
+                {new Date(slot.starts_at).toLocaleString()} – {new Date(slot.ends_at).toLocaleTimeString()} • {slot.interviewer_name}
+              </Typography>
+              <Button size="small" onClick={() => handleBook(slot)}>Reservar</Button>
+            </Box>
+          ))}
+        </Box>
+      )}
+      <Box display="flex" gap={1} mt={1}>
+        {canManage && editing === null && <Button size="small" variant="outlined" onClick={() => openForm()}>Agendar entrevista</Button>}
+        {!canManage && !interviews.some((i) => i.status === 'SCHEDULED') && (
+          <Button size="small" variant="outlined" onClick={loadSlots}>Escolher horário</Button>
+        )}
+        <Button size="small" onClick={() => setInterviews(null)}>Fechar</Button>
+      </Box>
+    </Box>
+  );
+};
+
+export default ApplicationInterviews;
@@ -0,0 +1,201 @@
+import React, { useState } from 'react';
+import { Autocomplete, Box, Button, Checkbox, Chip, FormControlLabel, TextField, Tooltip, Typography } from '@mui/material';
+import api from '../../shared/lib/api';
+import type { ApplicationNote, OrganizationMember } from '../../domain/types';
+import { useToast } from '../context/toastBase';
+
+type Props = {
+  applicationId: number;
+  notes: ApplicationNote[];
+  team: OrganizationMember[];
+  currentUserId?: number;
+};
+
+type Draft = {
+  body: string;
+  mentions: OrganizationMember[];
+  private: boolean;
+};
+
+const emptyDraft: Draft = { body: '', mentions: [], private: false };
+
+// Internal notes of the hiring team on one application. Candidates never see
+// them; private threads only reach the recruiters who wrote in or were
+// mentioned in them.
+const ApplicationNotes: React.FC<Props> = ({ applicationId, notes: initialNotes, team, currentUserId }) => {
+  const [notes, setNotes] = useState<ApplicationNote[]>(initialNotes);
+  const [draft, setDraft] = useState<Draft>(emptyDraft);
+  const [replyTo, setReplyTo] = useState<number | null>(null);
+  const [editing, setEditing] = useState<number | null>(null);
+  const [reply, setReply] = useState<Draft>(emptyDraft);
+  const { showToast } = useToast();
+
+  const mentionable = team.filter((m) => m.user_id !== currentUserId);
+
+  const reload = async () => {
+    const res = await api.get<ApplicationNote[]>(`/applications/${applicationId}/notes`);
+    setNotes(res.data || []);
+  };
+
+  const submit = async (request: () => Promise<unknown>, fallback: string) => {
+    try {
+      await request();
+      await reload();
+      return true;
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || fallback;
+      showToast({ message, severity: 'error' });
+      return false;
+    }
+  };
+
+  const handleCreate = async () => {
+    const ok = await submit(() => api.post(`/applications/${applicationId}/notes`, {
+      body: draft.body,
+      private: draft.private,
+      mention_ids: draft.mentions.map((m) => m.user_id),
+    }), 'Falha ao salvar a nota');
+    if (ok) setDraft(emptyDraft);
+  };
+
+  const handleReply = async (parentId: number) => {
+    const ok = await submit(() => api.post(`/applications/${applicationId}/notes`, {
+      parent_id: parentId,
+      body: reply.body,
+      mention_ids: reply.mentions.map((m) => m.user_id),
+    }), 'Falha ao responder a nota');
+    if (ok) {
+      setReplyTo(null);
+      setReply(emptyDraft);
+    }
+  };
+
+  const handleEdit = async (noteId: number) => {
+    const ok = await submit(() => api.patch(`/applications/${applicationId}/notes/${noteId}`, {
+      body: reply.body,
+      mention_ids: reply.mentions.map((m) => m.user_id),
+    }), 'Falha ao editar a nota');
+    if (ok) {
+      setEditing(null);
+      setReply(emptyDraft);
+    }
+  };
+
+  const startEdit = (note: ApplicationNote) => {
+    setReplyTo(null);
+    setEditing(note.id);
+    setReply({
+      body: note.body,
+      mentions: team.filter((m) => note.mentions.some((n) => n.user_id === m.user_id)),
+      private: note.private,
+    });
+  };
+
+  const startReply = (noteId: number) => {
+    setEditing(null);
+    setReplyTo(noteId);
+    setReply(emptyDraft);
+  };
+
+  const cancel = () => {
+    setEditing(null);
+    setReplyTo(null);
+    setReply(emptyDraft);
+  };
+
+  const mentionField = (value: Draft, onChange: (value: Draft) => void) => (
+    <Autocomplete
+      multiple
+      size="small"
+      options={mentionable}
+      value={value.mentions}
+      getOptionLabel={(m) => m.name}
+      isOptionEqualToValue={(a, b) => a.user_id === b.user_id}
+      onChange={(_, mentions) => onChange({ ...value, mentions })}
+      renderInput={(params) => <TextField {...params} label="Mencionar" placeholder="@recrutador" />}
+      disabled={mentionable.length === 0}
+    />
+  );
+
+  const form = (value: Draft, onChange: (value: Draft) => void, onSave: () => void, label: string) => (
+    <Box display="flex" flexDirection="column" gap={1} mt={1}>
+      <TextField
+        multiline
+        minRows={2}
+        size="small"
+        label={label}
+        value={value.body}
+        onChange={(e) => onChange({ ...value, body: e.target.value })}
+      />
+      {mentionField(value, onChange)}
+      <Box display="flex" gap={1}>
+        <Button size="small" variant="contained" onClick={onSave} disabled={!value.body.trim()}>Salvar</Button>
+        <Button size="small" onClick={cancel}>Cancelar</Button>
+      </Box>
+    </Box>
+  );
+
+  const renderNote = (note: ApplicationNote, isReply: boolean) => (
+    <Box key={note.id} pl={isReply ? 3 : 0} py={0.5}>
+      <Box display="flex" gap={1} alignItems="center">
+        <Typography variant="subtitle2">{note.author_name}</Typography>
+        <Typography variant="caption" color="text.secondary">{new Date(note.created_at).toLocaleString()}</Typography>
+        {note.private && !isReply && <Chip label="Privada" size="small" variant="outlined" />}
+        {note.edited_at && (
+          <Tooltip title={(note.revisions || []).map((r) => `${new Date(r.edited_at).toLocaleString()}: ${r.body}`).join('\n')}>
+            <Typography variant="caption" color="text.secondary">(editada)</Typography>
+          </Tooltip>
+        )}
+      </Box>
+      {editing === note.id ? (
+        form(reply, setReply, () => handleEdit(note.id), 'Editar nota')
+      ) : (
+        <>
+          <Typography variant="body2" sx={{ whiteSpace: 'pre-wrap' }}>{note.body}</Typography>
+          {note.mentions.length > 0 && (
+            <Typography variant="caption" color="primary">{note.mentions.map((m) => `@${m.name}`).join(' ')}</Typography>
+          )}
+          <Box display="flex" gap={1}>
+            {!isReply && <Button size="small" onClick={() => startReply(note.id)}>Responder</Button>}
+            {note.author_id === currentUserId && <Button size="small" onClick={() => startEdit(note)}>Editar</Button>}
+          </Box>
+        </>
+      )}
+    </Box>
+  );
+
+  return (
+    <Box mt={1}>
+      <Typography variant="caption" color="text.secondary">Notas internas (não visíveis ao candidato)</Typography>
+      {notes.map((note) => (
+        <Box key={note.id} borderLeft={2} borderColor="divider" pl={1} my={1}>
+          {renderNote(note, false)}
+          {(note.replies || []).map((r) => renderNote(r, true))}
+          {replyTo === note.id && (
+            <Box pl={3}>{form(reply, setReply, () => handleReply(note.id), 'Resposta')}</Box>
+          )}
+        </Box>
+      ))}
+      <Box display="flex" flexDirection="column" gap={1} mt={1}>
+        <TextField
+          multiline
+          minRows={2}
+          size="small"
+          label="Nova nota"
+          value={draft.body}
+          onChange={(e) => setDraft({ ...draft, body: e.target.value })}
+        />
+        {mentionField(draft, setDraft)}
+        <Box display="flex" gap={1} alignItems="center">
+          <FormControlLabel
+            control={<Checkbox size="small" checked={draft.private} onChange={(e) => setDraft({ ...draft, private: e.target.checked })} />}
+            label="Privada (só autor e mencionados)"
+          />
+          <Button size="small" variant="contained" onClick={handleCreate} disabled={!draft.body.trim()}>Adicionar nota</Button>
+        </Box>
+      </Box>
+    </Box>
+  );
+};
+
+export default ApplicationNotes;
@@ -0,0 +1,215 @@
+import React, { useState } from 'react';
+import { Box, Button, Chip, MenuItem, TextField, Typography } from '@mui/material';
+import api from '../../shared/lib/api';
+import type { Offer, OfferStatus, SalaryPeriod } from '../../domain/types';
+import { useToast } from '../context/toastBase';
+
+type Props = {
+  applicationId: number;
+  // Recruiters draft and send offers; candidates answer them.
+  canManage?: boolean;
+  onChanged?: () => void;
+};
+
+const statusLabels: Record<OfferStatus, string> = {
+  DRAFT: 'Rascunho',
+  SENT: 'Enviada',
+  ACCEPTED: 'Aceita',
+  DECLINED: 'Recusada',
+  EXPIRED: 'Expirada',
+  WITHDRAWN: 'Retirada',
+};
+
+const periodLabels: Record<SalaryPeriod, string> = {
+  HOUR: 'por hora',
+  MONTH: 'por mês',
+  YEAR: 'por ano',
+};
+
+// datetime-local inputs work in local time without a zone.
+const toLocalInput = (iso: string) => {
+  const d = new Date(iso);
+  return new Date(d.getTime() - d.getTimezoneOffset() * 60000).toISOString().slice(0, 16);
+};
+
+const errorMessage = (err: unknown, fallback: string) =>
+  (err as { response?: { data?: { error?: string } } }).response?.data?.error || fallback;
+
+const ApplicationOffers: React.FC<Props> = ({ applicationId, canManage, onChanged }) => {
+  const [offers, setOffers] = useState<Offer[] | null>(null);
+  const [editing, setEditing] = useState<number | 'new' | null>(null);
+  const [salary, setSalary] = useState('');
+  const [currency, setCurrency] = useState('BRL');
+  const [period, setPeriod] = useState<SalaryPeriod>('MONTH');
+  const [startDate, setStartDate] = useState('');
+  const [expiresAt, setExpiresAt] = useState('');
+  const [benefits, setBenefits] = useState('');
+  const { showToast } = useToast();
+
+  const load = async () => {
+    try {
+      const res = await api.get<Offer[]>(`/applications/${applicationId}/offers`);
+      setOffers(res.data || []);
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao carregar as propostas'), severity: 'error' });
+    }
+  };
+
+  const openForm = (offer?: Offer) => {
+    setEditing(offer ? offer.id : 'new');
+    setSalary(offer ? String(offer.salary) : '');
+    setCurrency(offer?.currency || 'BRL');
+    setPeriod(offer?.period || 'MONTH');
+    setStartDate(offer?.start_date || '');
+    setExpiresAt(offer ? toLocalInput(offer.expires_at) : '');
+    setBenefits(offer?.benefits || '');
+  };
+
+  const handleSave = async () => {
+    const payload = {
+      salary: Number(salary),
+      currency,
+      period,
+      start_date: startDate,
+      expires_at: new Date(expiresAt).toISOString(),
+      benefits,
+    };
+    try {
+      if (editing === 'new') {
+        await api.post(`/applications/${applicationId}/offers`, payload);
+      } else {
+        await api.patch(`/offers/${editing}`, payload);
+      }
+      showToast({ message: 'Proposta salva como rascunho', severity: 'success' });
+      setEditing(null);
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao salvar a proposta'), severity: 'error' });
+    }
+  };
+
+  const handleSend = async (offer: Offer) => {
+    try {
+      await api.post(`/offers/${offer.id}/send`);
+      showToast({ message: 'Proposta enviada ao candidato', severity: 'success' });
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao enviar a proposta'), severity: 'error' });
+    }
+  };
+
+  const handleAccept = async (offer: Offer) => {
+    if (!window.confirm('Aceitar esta proposta?')) return;
+    try {
+      await api.post(`/offers/${offer.id}/accept`);
+      showToast({ message: 'Proposta aceita, parabéns!', severity: 'success' });
+      await load();
+      onChanged?.();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao aceitar a proposta'), severity: 'error' });
+      await load();
+    }
+  };
+
+  const handleDecline = async (offer: Offer) => {
+    const reason = window.prompt('Motivo da recusa (opcional)');
+    if (reason === null) return;
+    try {
+      await api.post(`/offers/${offer.id}/decline`, { reason });
+      showToast({ message: 'Proposta recusada', severity: 'success' });
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao recusar a proposta'), severity: 'error' });
+      await load();
+    }
+  };
+
+  const handleDownload = async (offer: Offer) => {
+    try {
+      const res = await api.get<Blob>(`/offers/${offer.id}/letter`, { responseType: 'blob' });
+      const url = URL.createObjectURL(res.data);
+      const link = document.createElement('a');
+      link.href = url;
+      link.download = 'offer-letter.pdf';
+      link.click();
+      URL.revokeObjectURL(url);
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao baixar a carta proposta'), severity: 'error' });
+    }
+  };
+
+  if (!offers) {
+    return <Button size="small" onClick={load}>Propostas</Button>;
+  }
+
+  const hasOpen = offers.some((o) => o.status === 'DRAFT' || o.status === 'SENT');
+
+  return (
+    <Box mt={1} p={1} border={1} borderColor="divider" borderRadius={1}>
+      <Typography variant="subtitle2">Propostas</Typography>
+      {offers.length === 0 && (
+        <Typography variant="caption" color="text.secondary">Nenhuma proposta.</Typography>
+      )}
+      {offers.map((offer) => (
+        <Box key={offer.id} py={0.5}>
+          <Box display="flex" alignItems="center" gap={1}>
+            <Typography variant="body2">
+              {offer.currency} {offer.salary.toLocaleString()} {periodLabels[offer.period]} • início em {new Date(`${offer.start_date}T00:00:00`).toLocaleDateString()}
+            </Typography>
+            <Chip size="small" variant="outlined" label={statusLabels[offer.status]} />
+          </Box>
+          <Typography variant="caption" color="text.secondary" display="block">
+            Válida até {new Date(offer.expires_at).toLocaleString()} • {offer.created_by_name}
+          </Typography>
+          {offer.benefits && <Typography variant="caption" display="block">Benefícios: {offer.benefits}</Typography>}
+          {offer.decline_reason && <Typography variant="caption" display="block">Motivo: {offer.decline_reason}</Typography>}
+          <Box display="flex" gap={1}>
+            <Button size="small" onClick={() => handleDownload(offer)}>Carta proposta</Button>
+            {canManage && offer.status === 'DRAFT' && (
+              <>
+                <Button size="small" onClick={() => openForm(offer)}>Editar</Button>
+                <Button size="small" variant="contained" onClick={() => handleSend(offer)}>Enviar</Button>
+              </>
+            )}
+            {!canManage && offer.status === 'SENT' && (
+              <>
+                <Button size="small" variant="contained" color="success" onClick={() => handleAccept(offer)}>Aceitar</Button>
+                <Button size="small" color="error" onClick={() => handleDecline(offer)}>Recusar</Button>
+              </>
+            )}
+          </Box>
+        </Box>
+      ))}
+      {canManage && editing !== null && (
+        <Box display="flex" flexDirection="column" gap={1} mt={1}>
+          <Box display="flex" gap={1}>
+            <TextField size="small" type="number" label="Salário" value={salary} onChange={(e) => setSalary(e.target.value)} />
+            <TextField size="small" label="Moeda" value={currency} onChange={(e) => setCurrency(e.target.value.toUpperCase())} sx={{ width: 90 }} />
+            <TextField select size="small" label="Período" value={period} onChange={(e) => setPeriod(e.target.value as SalaryPeriod)} sx={{ minWidth: 120 }}>
+              <MenuItem value="HOUR">Por hora</MenuItem>
+              <MenuItem value="MONTH">Por mês</MenuItem>
+              <MenuItem value="YEAR">Por ano</MenuItem>
+            </TextField>
+          </Box>
+          <Box display="flex" gap={1}>
+            <TextField size="small" type="date" label="Início" InputLabelProps={{ shrink: true }} value={startDate} onChange={(e) => setStartDate(e.target.value)} />
+            <TextField size="small" type="datetime-local" label="Válida até" InputLabelProps={{ shrink: true }} value={expiresAt} onChange={(e) => setExpiresAt(e.target.value)} />
+          </Box>
+          <TextField size="small" label="Benefícios" multiline minRows={2} value={benefits} onChange={(e) => setBenefits(e.target.value)} />
+          <Box display="flex" gap={1}>
+            <Button size="small" variant="contained" onClick={handleSave} disabled={!salary || !startDate || !expiresAt}>
+              Salvar rascunho
+            </Button>
+            <Button size="small" onClick={() => setEditing(null)}>Voltar</Button>
+          </Box>
+        </Box>
+      )}
+      <Box display="flex" gap={1} mt={1}>
+        {canManage && editing === null && !hasOpen && <Button size="small" variant="outlined" onClick={() => openForm()}>Nova proposta</Button>}
+        <Button size="small" onClick={() => setOffers(null)}>Fechar</Button>
+      </Box>
+    </Box>
+  );
+};
+
+export default ApplicationOffers;
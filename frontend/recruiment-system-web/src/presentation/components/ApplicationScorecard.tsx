@@ -0,0 +1,103 @@
+import React, { useState } from 'react';
+import { Box, Button, Rating, TextField, Typography } from '@mui/material';
+import api from '../../shared/lib/api';
+import type { ApplicationScorecards, Scorecard } from '../../domain/types';
+import { useToast } from '../context/toastBase';
+
+type Props = {
+  applicationId: number;
+  onSubmitted?: () => void;
+};
+
+// Scorecard of one application. The other interviewers' scorecards are only
+// returned after the current user submits their own.
+const ApplicationScorecard: React.FC<Props> = ({ applicationId, onSubmitted }) => {
+  const [data, setData] = useState<ApplicationScorecards | null>(null);
+  const [scores, setScores] = useState<Record<number, number>>({});
+  const [comment, setComment] = useState('');
+  const [open, setOpen] = useState(false);
+  const { showToast } = useToast();
+
+  const load = async () => {
+    try {
+      const res = await api.get<ApplicationScorecards>(`/applications/${applicationId}/scorecards`);
+      setData(res.data);
+      const mine = res.data.mine;
+      setScores(Object.fromEntries((mine?.ratings || []).map((r) => [r.criterion_id, r.score])));
+      setComment(mine?.comment || '');
+      setOpen(true);
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao carregar as avaliações';
+      showToast({ message, severity: 'error' });
+    }
+  };
+
+  const handleSubmit = async () => {
+    if (!data) return;
+    try {
+      await api.put(`/applications/${applicationId}/scorecard`, {
+        ratings: data.criteria.map((c) => ({ criterion_id: c.id, score: scores[c.id] })),
+        comment,
+      });
+      showToast({ message: 'Avaliação enviada', severity: 'success' });
+      await load();
+      onSubmitted?.();
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao enviar a avaliação';
+      showToast({ message, severity: 'error' });
+    }
+  };
+
+  const renderScorecard = (card: Scorecard) => (
+    <Box key={card.id} py={0.5}>
+      <Typography variant="subtitle2">{card.interviewer_name || 'Você'} • média {card.average.toFixed(2)}</Typography>
+      <Typography variant="caption" color="text.secondary" display="block">
+        {card.ratings.map((r) => `${r.criterion}: ${r.score}`).join(' • ')}
+      </Typography>
+      {card.comment && <Typography variant="body2">{card.comment}</Typography>}
+    </Box>
+  );
+
+  if (!open || !data) {
+    return <Button size="small" onClick={load}>Avaliação</Button>;
+  }
+
+  const complete = data.criteria.every((c) => scores[c.id]);
+
+  return (
+    <Box mt={1} p={1} border={1} borderColor="divider" borderRadius={1}>
+      <Typography variant="subtitle2">Minha avaliação</Typography>
+      {data.criteria.map((c) => (
+        <Box key={c.id} display="flex" alignItems="center" gap={1}>
+          <Typography variant="body2" sx={{ minWidth: 140 }}>{c.name}</Typography>
+          <Rating
+            value={scores[c.id] || null}
+            max={5}
+            onChange={(_, value) => setScores({ ...scores, [c.id]: value || 0 })}
+          />
+        </Box>
+      ))}
+      <TextField fullWidth multiline minRows={2} size="small" label="Comentário" value={comment} onChange={(e) => setComment(e.target.value)} sx={{ mt: 1 }} />
+      <Box display="flex" gap={1} mt={1}>
+        <Button size="small" variant="contained" onClick={handleSubmit} disabled={!complete}>{data.mine ? 'Atualizar avaliação' : 'Enviar avaliação'}</Button>
+        <Button size="small" onClick={() => setOpen(false)}>Fechar</Button>
+      </Box>
+      <Box mt={1}>
+        {data.hidden ? (
+          <Typography variant="caption" color="text.secondary">
+            {data.count > 0 ? `${data.count} avaliação(ões) ocultas até você enviar a sua.` : 'Nenhuma avaliação ainda.'}
+          </Typography>
+        ) : (
+          <>
+            {data.average !== undefined && (
+              <Typography variant="body2">Média geral: {data.average.toFixed(2)} ({data.count} avaliação(ões))</Typography>
+            )}
+            {data.scorecards.map(renderScorecard)}
+          </>
+        )}
+      </Box>
+    </Box>
+  );
+};
+
+export default ApplicationScorecard;
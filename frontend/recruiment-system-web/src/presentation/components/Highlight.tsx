@@ -0,0 +1,23 @@
+import React from 'react';
+import { Box } from '@mui/material';
+
+type HighlightProps = {
+  text: string;
+};
+
+// Renders a search snippet whose matches are wrapped in <mark></mark> by the
+// API. The snippet is split on the markers instead of being injected as HTML.
+const Highlight: React.FC<HighlightProps> = ({ text }) => {
+  const parts = text.split(/<mark>|<\/mark>/);
+  return (
+    <>
+      {parts.map((part, i) =>
+        i % 2 === 1
+          ? <Box key={i} component="mark" sx={{ bgcolor: 'warning.light', px: 0.25 }}>{part}</Box>
+          : <React.Fragment key={i}>{part}</React.Fragment>
+      )}
+    </>
+  );
+};
+
+export default Highlight;
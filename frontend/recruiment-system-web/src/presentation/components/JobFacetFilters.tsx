@@ -0,0 +1,80 @@
+import React from 'react';
+import { Box, Chip, Typography } from '@mui/material';
+import type { JobFacets, JobFacetSelection } from '../../domain/types';
+import { employmentTypeLabels, seniorityLabels, workModelLabels } from '../../shared/lib/taxonomy';
+
+const facetLabels: Record<keyof JobFacets, string> = {
+  location: 'Local',
+  company: 'Empresa',
+  employment_type: 'Contratação',
+  seniority: 'Senioridade',
+  work_model: 'Modelo de trabalho',
+  salary_band: 'Faixa salarial',
+  posted_within: 'Publicada em',
+};
+
+const valueLabels: Record<string, string> = {
+  ...employmentTypeLabels,
+  ...seniorityLabels,
+  ...workModelLabels,
+  '0-3000': 'Até 3 mil',
+  '3000-5000': '3 a 5 mil',
+  '5000-8000': '5 a 8 mil',
+  '8000-12000': '8 a 12 mil',
+  '12000+': 'Acima de 12 mil',
+  '24h': 'Últimas 24h',
+  '7d': 'Últimos 7 dias',
+  '30d': 'Últimos 30 dias',
+};
+
+// Facets accepting a single value; picking another replaces the selection.
+const singleValue: (keyof JobFacets)[] = ['salary_band', 'posted_within'];
+
+type JobFacetFiltersProps = {
+  facets: JobFacets;
+  selected: JobFacetSelection;
+  onChange: (selected: JobFacetSelection) => void;
+};
+
+const JobFacetFilters: React.FC<JobFacetFiltersProps> = ({ facets, selected, onChange }) => {
+  const toggle = (facet: keyof JobFacets, value: string) => {
+    const current = selected[facet] || [];
+    let next: string[];
+    if (current.includes(value)) {
+      next = current.filter(v => v !== value);
+    } else {
+      next = singleValue.includes(facet) ? [value] : [...current, value];
+    }
+    onChange({ ...selected, [facet]: next });
+  };
+
+  return (
+    <Box display="flex" flexDirection="column" gap={1.5} mb={3}>
+      {(Object.keys(facetLabels) as (keyof JobFacets)[]).map(facet => {
+        const counts = facets[facet] || [];
+        if (counts.length === 0) return null;
+        return (
+          <Box key={facet} display="flex" alignItems="center" gap={1} flexWrap="wrap">
+            <Typography variant="subtitle2" sx={{ minWidth: 150 }}>{facetLabels[facet]}</Typography>
+            {counts.map(c => {
+              const active = (selected[facet] || []).includes(c.value);
+              return (
+                <Chip
+                  key={c.value}
+                  size="small"
+                  label={`${valueLabels[c.value] || c.value} (${c.count})`}
+                  color={active ? 'primary' : 'default'}
+                  variant={active ? 'filled' : 'outlined'}
+                  disabled={!active && c.count === 0}
+                  onClick={() => toggle(facet, c.value)}
+                />
+              );
+            })}
+          </Box>
+        );
+      })}
+    </Box>
+  );
+};
+
+export default JobFacetFilters;
@@ -0,0 +1,129 @@
+import React, { useState } from 'react';
+import { Box, Button, Card, CardContent, Chip, MenuItem, TextField, Typography } from '@mui/material';
+import api from '../../shared/lib/api';
+import type { InterviewSlot, OrganizationMember, PipelineStage } from '../../domain/types';
+import { useToast } from '../context/toastBase';
+
+type Props = {
+  jobId: number;
+  team: OrganizationMember[];
+};
+
+const errorMessage = (err: unknown, fallback: string) =>
+  (err as { response?: { data?: { error?: string } } }).response?.data?.error || fallback;
+
+// Availability windows of the hiring team. Each window is split into slots
+// candidates book from their applications.
+const JobInterviewSlots: React.FC<Props> = ({ jobId, team }) => {
+  const [slots, setSlots] = useState<InterviewSlot[] | null>(null);
+  const [stages, setStages] = useState<PipelineStage[]>([]);
+  const [startsAt, setStartsAt] = useState('');
+  const [endsAt, setEndsAt] = useState('');
+  const [duration, setDuration] = useState('45');
+  const [interviewerId, setInterviewerId] = useState<number | ''>('');
+  const [stageId, setStageId] = useState<number | ''>('');
+  const [location, setLocation] = useState('');
+  const [videoURL, setVideoURL] = useState('');
+  const { showToast } = useToast();
+
+  const load = async () => {
+    try {
+      const res = await api.get<InterviewSlot[]>(`/jobs/${jobId}/interview-slots`);
+      setSlots(res.data || []);
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao carregar os horários'), severity: 'error' });
+      return;
+    }
+    try {
+      const res = await api.get<PipelineStage[]>(`/jobs/${jobId}/pipeline`);
+      setStages((res.data || []).filter((s) => s.kind === 'ACTIVE'));
+    } catch {
+      // Jobs outside an organization have no pipeline.
+      setStages([]);
+    }
+  };
+
+  const handlePublish = async () => {
+    try {
+      await api.post(`/jobs/${jobId}/interview-slots`, {
+        starts_at: new Date(startsAt).toISOString(),
+        ends_at: new Date(endsAt).toISOString(),
+        duration_minutes: Number(duration),
+        interviewer_id: interviewerId || undefined,
+        stage_id: stageId || undefined,
+        location,
+        video_url: videoURL,
+      });
+      showToast({ message: 'Horários publicados', severity: 'success' });
+      setStartsAt('');
+      setEndsAt('');
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao publicar os horários'), severity: 'error' });
+    }
+  };
+
+  const handleDelete = async (slot: InterviewSlot) => {
+    try {
+      await api.delete(`/jobs/${jobId}/interview-slots/${slot.id}`);
+      await load();
+    } catch (err: unknown) {
+      showToast({ message: errorMessage(err, 'Falha ao remover o horário'), severity: 'error' });
+    }
+  };
+
+  return (
+    <Card elevation={0} variant="outlined" sx={{ mt: 4 }}>
+      <CardContent>
+        <Box display="flex" justifyContent="space-between" alignItems="center">
+          <Typography variant="h6">Disponibilidade para entrevistas</Typography>
+          {!slots && <Button size="small" onClick={load}>Ver horários</Button>}
+        </Box>
+        {slots && (
+          <>
+            <Box display="flex" flexWrap="wrap" gap={1} mt={2}>
+              <TextField size="small" type="datetime-local" label="Início" InputLabelProps={{ shrink: true }} value={startsAt} onChange={(e) => setStartsAt(e.target.value)} />
+              <TextField size="small" type="datetime-local" label="Fim" InputLabelProps={{ shrink: true }} value={endsAt} onChange={(e) => setEndsAt(e.target.value)} />
+              <TextField size="small" type="number" label="Duração (min)" value={duration} onChange={(e) => setDuration(e.target.value)} sx={{ width: 130 }} />
+              <TextField select size="small" label="Entrevistador" value={interviewerId} onChange={(e) => setInterviewerId(e.target.value === '' ? '' : Number(e.target.value))} sx={{ minWidth: 180 }}>
+                <MenuItem value="">Eu</MenuItem>
+                {team.map((m) => (
+                  <MenuItem key={m.user_id} value={m.user_id}>{m.name}</MenuItem>
+                ))}
+              </TextField>
+              {stages.length > 0 && (
+                <TextField select size="small" label="Etapa" value={stageId} onChange={(e) => setStageId(e.target.value === '' ? '' : Number(e.target.value))} sx={{ minWidth: 180 }}>
+                  <MenuItem value="">Qualquer etapa</MenuItem>
+                  {stages.map((s) => (
+                    <MenuItem key={s.id} value={s.id}>{s.name}</MenuItem>
+                  ))}
+                </TextField>
+              )}
+              <TextField size="small" label="Local" value={location} onChange={(e) => setLocation(e.target.value)} />
+              <TextField size="small" label="Link da videochamada" value={videoURL} onChange={(e) => setVideoURL(e.target.value)} />
+              <Button variant="contained" onClick={handlePublish} disabled={!startsAt || !endsAt || !duration}>Publicar horários</Button>
+            </Box>
+            <Box mt={2}>
+              {slots.length === 0 && <Typography variant="body2" color="text.secondary">Nenhum horário futuro publicado.</Typography>}
+              {slots.map((slot) => (
+                <Box key={slot.id} display="flex" alignItems="center" gap={1} py={0.5}>
+                  <Typography variant="body2">
+                    {new Date(slot.starts_at).toLocaleString()} – {new Date(slot.ends_at).toLocaleTimeString()} • {slot.interviewer_name}
+                  </Typography>
+                  {slot.stage_id && <Chip size="small" variant="outlined" label={stages.find((s) => s.id === slot.stage_id)?.name || 'Etapa'} />}
+                  {slot.booked ? (
+                    <Chip size="small" color="primary" label={slot.candidate_name ? `Reservado: ${slot.candidate_name}` : 'Reservado'} />
+                  ) : (
+                    <Button size="small" color="error" onClick={() => handleDelete(slot)}>Remover</Button>
+                  )}
+                </Box>
+              ))}
+            </Box>
+          </>
+        )}
+      </CardContent>
+    </Card>
+  );
+};
+
+export default JobInterviewSlots;
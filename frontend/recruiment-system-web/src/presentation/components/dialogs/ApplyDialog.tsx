@@ -0,0 +1,158 @@
+import React, { useState } from 'react';
+import { Dialog, DialogTitle, DialogContent, DialogActions, Button, Box, Typography, TextField, MenuItem, FormControlLabel, Checkbox, FormGroup, FormLabel } from '@mui/material';
+import type { ScreeningQuestion } from '../../../domain/types';
+
+type ApplyDialogProps = {
+  open: boolean;
+  jobTitle?: string;
+  questions?: ScreeningQuestion[];
+  onCancel: () => void;
+  onSubmit: (form: FormData) => Promise<void> | void;
+};
+
+const ACCEPT = '.pdf,.docx,application/pdf,application/vnd.openxmlformats-officedocument.wordprocessingml.document';
+const MAX_SIZE = 5 * 1024 * 1024;
+
+const ApplyDialog: React.FC<ApplyDialogProps> = ({ open, jobTitle, questions = [], onCancel, onSubmit }) => {
+  const [resume, setResume] = useState<File | null>(null);
+  const [answers, setAnswers] = useState<Record<number, string[]>>({});
+  const [coverLetter, setCoverLetter] = useState<File | null>(null);
+  const [error, setError] = useState('');
+  const [submitting, setSubmitting] = useState(false);
+
+  const reset = () => {
+    setResume(null);
+    setCoverLetter(null);
+    setAnswers({});
+    setError('');
+  };
+
+  const setAnswer = (id: number, values: string[]) => setAnswers(prev => ({ ...prev, [id]: values }));
+
+  const toggleOption = (id: number, option: string) => {
+    const current = answers[id] || [];
+    setAnswer(id, current.includes(option) ? current.filter(o => o !== option) : [...current, option]);
+  };
+
+  const pick = (setter: (f: File | null) => void) => (e: React.ChangeEvent<HTMLInputElement>) => {
+    const file = e.target.files?.[0] || null;
+    if (file && file.size > MAX_SIZE) {
+      setError('Cada arquivo deve ter no máximo 5 MB.');
+      return;
+    }
+    setError('');
+    setter(file);
+  };
+
+  const handleCancel = () => {
+    reset();
+    onCancel();
+  };
+
+  const handleSubmit = async () => {
+    if (!resume) {
+      setError('Envie seu currículo em PDF ou DOCX.');
+      return;
+    }
+    const missing = questions.find(q => q.required && !(answers[q.id] || []).some(v => v.trim() !== ''));
+    if (missing) {
+      setError(`Responda: ${missing.prompt}`);
+      return;
+    }
+    const form = new FormData();
+    form.append('resume', resume);
+    if (coverLetter) form.append('cover_letter', coverLetter);
+    if (questions.length > 0) {
+      form.append('answers', JSON.stringify(questions
+        .filter(q => (answers[q.id] || []).length > 0)
+        .map(q => q.type === 'MULTIPLE_CHOICE'
+          ? { question_id: q.id, values: answers[q.id] }
+          : { question_id: q.id, value: answers[q.id][0] })));
+    }
+    setSubmitting(true);
+    try {
+      await onSubmit(form);
+      reset();
+    } finally {
+      setSubmitting(false);
+    }
+  };
+
+  return (
+    <Dialog open={open} onClose={handleCancel} fullWidth maxWidth="sm">
+      <DialogTitle>{jobTitle ? `Candidatar-se: ${jobTitle}` : 'Candidatar-se'}</DialogTitle>
+      <DialogContent>
+        <Box display="flex" flexDirection="column" gap={2} mt={1}>
+          <Box>
+            <Button variant="outlined" component="label">
+              Currículo (obrigatório)
+              <input hidden type="file" accept={ACCEPT} onChange={pick(setResume)} />
+            </Button>
+            <Typography variant="caption" color="text.secondary" ml={2}>{resume?.name || 'Nenhum arquivo'}</Typography>
+          </Box>
+          <Box>
+            <Button variant="outlined" component="label">
+              Carta de apresentação
+              <input hidden type="file" accept={ACCEPT} onChange={pick(setCoverLetter)} />
+            </Button>
+            <Typography variant="caption" color="text.secondary" ml={2}>{coverLetter?.name || 'Opcional'}</Typography>
+          </Box>
+          <Typography variant="caption" color="text.secondary">Formatos aceitos: PDF ou DOCX, até 5 MB cada.</Typography>
+          {questions.map((q) => {
+            const label = `${q.prompt}${q.required ? ' *' : ''}`;
+            const value = (answers[q.id] || [])[0] || '';
+            switch (q.type) {
+              case 'YES_NO':
+                return (
+                  <TextField key={q.id} select size="small" label={label} value={value} onChange={(e) => setAnswer(q.id, [e.target.value])}>
+                    <MenuItem value="yes">Sim</MenuItem>
+                    <MenuItem value="no">Não</MenuItem>
+                  </TextField>
+                );
+              case 'SINGLE_CHOICE':
+                return (
+                  <TextField key={q.id} select size="small" label={label} value={value} onChange={(e) => setAnswer(q.id, [e.target.value])}>
+                    {(q.options || []).map((o) => <MenuItem key={o} value={o}>{o}</MenuItem>)}
+                  </TextField>
+                );
+              case 'MULTIPLE_CHOICE':
+                return (
+                  <Box key={q.id}>
+                    <FormLabel>{label}</FormLabel>
+                    <FormGroup>
+                      {(q.options || []).map((o) => (
+                        <FormControlLabel
+                          key={o}
+                          label={o}
+                          control={<Checkbox checked={(answers[q.id] || []).includes(o)} onChange={() => toggleOption(q.id, o)} />}
+                        />
+                      ))}
+                    </FormGroup>
+                  </Box>
+                );
+              default:
+                return (
+                  <TextField
+                    key={q.id}
+                    size="small"
+                    label={label}
+                    type={q.type === 'NUMBER' ? 'number' : 'text'}
+                    multiline={q.type === 'TEXT'}
+                    value={value}
+                    onChange={(e) => setAnswer(q.id, [e.target.value])}
+                  />
+                );
+            }
+          })}
+          {error && <Typography variant="body2" color="error">{error}</Typography>}
+        </Box>
+      </DialogContent>
+      <DialogActions>
+        <Button onClick={handleCancel}>Cancelar</Button>
+        <Button onClick={handleSubmit} variant="contained" disabled={submitting}>Enviar candidatura</Button>
+      </DialogActions>
+    </Dialog>
+  );
+};
+
+export default ApplyDialog;
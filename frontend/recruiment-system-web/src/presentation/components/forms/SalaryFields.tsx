@@ -0,0 +1,40 @@
+import React from 'react';
+import { Box, TextField, MenuItem, FormControlLabel, Switch } from '@mui/material';
+import type { SalaryPeriod } from '../../../domain/types';
+import type { SalaryForm } from '../../../shared/lib/salary';
+
+type SalaryFieldsProps = {
+  value: SalaryForm;
+  onChange: (value: SalaryForm) => void;
+  disabled?: boolean;
+};
+
+const SalaryFields: React.FC<SalaryFieldsProps> = ({ value, onChange, disabled }) => {
+  return (
+    <>
+      <Box display="flex" gap={2}>
+        <TextField fullWidth type="number" label="Salário mínimo" margin="normal" value={value.min} onChange={(e) => onChange({ ...value, min: e.target.value })} disabled={disabled} />
+        <TextField fullWidth type="number" label="Salário máximo" margin="normal" value={value.max} onChange={(e) => onChange({ ...value, max: e.target.value })} disabled={disabled} />
+      </Box>
+      <Box display="flex" gap={2}>
+        <TextField select fullWidth label="Moeda" margin="normal" value={value.currency} onChange={(e) => onChange({ ...value, currency: e.target.value })} disabled={disabled}>
+          <MenuItem value="BRL">BRL</MenuItem>
+          <MenuItem value="USD">USD</MenuItem>
+          <MenuItem value="EUR">EUR</MenuItem>
+          <MenuItem value="GBP">GBP</MenuItem>
+        </TextField>
+        <TextField select fullWidth label="Período" margin="normal" value={value.period} onChange={(e) => onChange({ ...value, period: e.target.value as SalaryPeriod })} disabled={disabled}>
+          <MenuItem value="HOUR">Por hora</MenuItem>
+          <MenuItem value="MONTH">Por mês</MenuItem>
+          <MenuItem value="YEAR">Por ano</MenuItem>
+        </TextField>
+      </Box>
+      <FormControlLabel
+        control={<Switch checked={value.visible} onChange={(e) => onChange({ ...value, visible: e.target.checked })} disabled={disabled} />}
+        label="Exibir salário aos candidatos"
+      />
+    </>
+  );
+};
+
+export default SalaryFields;
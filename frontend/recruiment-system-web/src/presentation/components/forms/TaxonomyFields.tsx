@@ -0,0 +1,47 @@
+import React, { useEffect, useState } from 'react';
+import { Box, TextField, MenuItem } from '@mui/material';
+import api from '../../../shared/lib/api';
+import type { Category, EmploymentType, Seniority, WorkModel } from '../../../domain/types';
+import { employmentTypeLabels, seniorityLabels, workModelLabels } from '../../../shared/lib/taxonomy';
+import type { TaxonomyForm } from '../../../shared/lib/taxonomy';
+
+type TaxonomyFieldsProps = {
+  value: TaxonomyForm;
+  onChange: (value: TaxonomyForm) => void;
+  disabled?: boolean;
+};
+
+const TaxonomyFields: React.FC<TaxonomyFieldsProps> = ({ value, onChange, disabled }) => {
+  const [categories, setCategories] = useState<Category[]>([]);
+
+  useEffect(() => {
+    api.get<Category[]>('/categories')
+      .then(res => setCategories(res.data || []))
+      .catch(() => setCategories([]));
+  }, []);
+
+  return (
+    <>
+      <TextField select fullWidth label="Categoria" margin="normal" value={value.category_id} onChange={(e) => onChange({ ...value, category_id: e.target.value === '' ? '' : Number(e.target.value) })} disabled={disabled}>
+        <MenuItem value="">Sem categoria</MenuItem>
+        {categories.map(c => <MenuItem key={c.id} value={c.id}>{c.name}</MenuItem>)}
+      </TextField>
+      <Box display="flex" gap={2}>
+        <TextField select fullWidth label="Contratação" margin="normal" value={value.employment_type} onChange={(e) => onChange({ ...value, employment_type: e.target.value as EmploymentType | '' })} disabled={disabled}>
+          <MenuItem value="">Não informado</MenuItem>
+          {Object.entries(employmentTypeLabels).map(([k, label]) => <MenuItem key={k} value={k}>{label}</MenuItem>)}
+        </TextField>
+        <TextField select fullWidth label="Senioridade" margin="normal" value={value.seniority} onChange={(e) => onChange({ ...value, seniority: e.target.value as Seniority | '' })} disabled={disabled}>
+          <MenuItem value="">Não informado</MenuItem>
+          {Object.entries(seniorityLabels).map(([k, label]) => <MenuItem key={k} value={k}>{label}</MenuItem>)}
+        </TextField>
+        <TextField select fullWidth label="Modelo de trabalho" margin="normal" value={value.work_model} onChange={(e) => onChange({ ...value, work_model: e.target.value as WorkModel | '' })} disabled={disabled}>
+          <MenuItem value="">Não informado</MenuItem>
+          {Object.entries(workModelLabels).map(([k, label]) => <MenuItem key={k} value={k}>{label}</MenuItem>)}
+        </TextField>
+      </Box>
+    </>
+  );
+};
+
+export default TaxonomyFields;
@@ -19,6 +19,7 @@ export const AuthProvider: React.FC<{ children: React.ReactNode }> = ({ children
 
             const jwtToken = output.token;
             localStorage.setItem('token', jwtToken);
+            localStorage.setItem('refresh_token', output.refresh_token);
             setToken(jwtToken);
 
             const payload = JSON.parse(atob(jwtToken.split('.')[1]));
@@ -48,7 +49,11 @@ export const AuthProvider: React.FC<{ children: React.ReactNode }> = ({ children
     };
 
     const logout = () => {
+        if (token) {
+            api.post('/logout', null, { headers: { Authorization: `Bearer ${token}` } }).catch(() => undefined);
+        }
         localStorage.removeItem('token');
+        localStorage.removeItem('refresh_token');
         localStorage.removeItem('user');
         setToken(null);
         setUser(null);
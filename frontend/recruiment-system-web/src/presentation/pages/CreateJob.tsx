@@ -3,6 +3,11 @@ import { Container, Typography, TextField, Button, Box, Paper, Alert, FormContro
 import { useToast } from '../context/toastBase';
 import api from '../../data/api';
 import { useNavigate } from 'react-router-dom';
+import SalaryFields from '../components/forms/SalaryFields';
+import TaxonomyFields from '../components/forms/TaxonomyFields';
+import { emptySalaryForm, toSalaryInput } from '../../shared/lib/salary';
+import { emptyTaxonomyForm, toTaxonomyInput } from '../../shared/lib/taxonomy';
+import { fromDateTimeInput } from '../../shared/lib/jobStatus';
 
 const CreateJob: React.FC = () => {
     const [title, setTitle] = useState('');
@@ -10,20 +15,24 @@ const CreateJob: React.FC = () => {
     const [company, setCompany] = useState('');
     const [location, setLocation] = useState('');
     const [requirements, setRequirements] = useState('');
-    const [salary, setSalary] = useState('');
+    const [taxonomy, setTaxonomy] = useState(emptyTaxonomyForm);
+    const [salary, setSalary] = useState(emptySalaryForm);
     const [anonymous, setAnonymous] = useState(false);
+    const [publishAt, setPublishAt] = useState('');
+    const [expiresAt, setExpiresAt] = useState('');
+    const [openings, setOpenings] = useState(1);
+    const [criteria, setCriteria] = useState('Technical, Communication, Culture');
     const { showToast } = useToast();
     const [error, setError] = useState('');
     const [loading, setLoading] = useState(false);
     const navigate = useNavigate();
 
-    const handleSubmit = async (e: React.FormEvent) => {
-        e.preventDefault();
+    const save = async (draft: boolean) => {
         setLoading(true);
         setError('');
         try {
-            await api.post('/jobs', { title, description, company, location, requirements, salary, anonymous });
-            showToast({ message: 'Vaga criada com sucesso', severity: 'success' });
+            await api.post('/jobs', { draft, title, description, company, location, requirements, ...toTaxonomyInput(taxonomy), salary: toSalaryInput(salary), anonymous, openings, criteria: criteria.split(',').map((c) => c.trim()).filter(Boolean), publish_at: fromDateTimeInput(publishAt), expires_at: fromDateTimeInput(expiresAt) });
+            showToast({ message: draft ? 'Rascunho salvo' : 'Vaga criada com sucesso', severity: 'success' });
             navigate('/jobs');
         } catch (err: unknown) {
             const serverMsg = (err as { response?: { data?: { error?: string } } }).response?.data?.error;
@@ -35,6 +44,11 @@ const CreateJob: React.FC = () => {
         }
     };
 
+    const handleSubmit = (e: React.FormEvent) => {
+        e.preventDefault();
+        save(false);
+    };
+
     return (
         <Container maxWidth="sm">
             <Box display="flex" justifyContent="space-between" alignItems="center" mb={4}>
@@ -87,13 +101,47 @@ const CreateJob: React.FC = () => {
                         value={requirements}
                         onChange={(e) => setRequirements(e.target.value)}
                     />
+                    <TaxonomyFields value={taxonomy} onChange={setTaxonomy} />
+                    <SalaryFields value={salary} onChange={setSalary} />
+                    <TextField
+                        fullWidth
+                        type="number"
+                        label="Número de Vagas"
+                        margin="normal"
+                        inputProps={{ min: 1 }}
+                        helperText="A vaga fecha quando todas forem preenchidas"
+                        value={openings}
+                        onChange={(e) => setOpenings(Math.max(1, Number(e.target.value) || 1))}
+                    />
                     <TextField
                         fullWidth
-                        label="Faixa Salarial (Opcional)"
+                        label="Critérios de Avaliação"
                         margin="normal"
-                        value={salary}
-                        onChange={(e) => setSalary(e.target.value)}
+                        helperText="Separados por vírgula; cada critério recebe nota de 1 a 5"
+                        value={criteria}
+                        onChange={(e) => setCriteria(e.target.value)}
                     />
+                    <Box display="flex" gap={2}>
+                        <TextField
+                            fullWidth
+                            type="datetime-local"
+                            label="Publicar em (Opcional)"
+                            margin="normal"
+                            InputLabelProps={{ shrink: true }}
+                            helperText="Em branco publica agora"
+                            value={publishAt}
+                            onChange={(e) => setPublishAt(e.target.value)}
+                        />
+                        <TextField
+                            fullWidth
+                            type="datetime-local"
+                            label="Expira em (Opcional)"
+                            margin="normal"
+                            InputLabelProps={{ shrink: true }}
+                            value={expiresAt}
+                            onChange={(e) => setExpiresAt(e.target.value)}
+                        />
+                    </Box>
 
                     <FormControlLabel
                         control={<Switch checked={anonymous} onChange={(e) => setAnonymous(e.target.checked)} />}
@@ -110,6 +158,15 @@ const CreateJob: React.FC = () => {
                     >
                         {loading ? 'Publicando...' : 'Criar Vaga'}
                     </Button>
+                    <Button
+                        variant="outlined"
+                        fullWidth
+                        sx={{ mt: 1 }}
+                        disabled={loading || !title.trim()}
+                        onClick={() => save(true)}
+                    >
+                        Salvar como Rascunho
+                    </Button>
                 </Box>
             </Paper>
         </Container>
@@ -5,11 +5,15 @@ import {
     Pagination, CircularProgress, Alert, Chip, Divider, Card, CardContent, MenuItem
 } from '@mui/material';
 import api from '../../shared/lib/api';
-import type { Job, PaginatedResponse, DashboardStats, RecruiterStats, Application } from '../../domain/types';
+import type { Job, PaginatedResponse, PaginatedJobs, JobFacets, JobFacetSelection, DashboardStats, RecruiterStats, Application } from '../../domain/types';
 import { Role } from '../../domain/types';
 import { useAuth } from '../context/useAuth';
 import { useNavigate } from 'react-router-dom';
 import { useToast } from '../context/toastBase';
+import ApplyDialog from '../components/dialogs/ApplyDialog';
+import Highlight from '../components/Highlight';
+import JobFacetFilters from '../components/JobFacetFilters';
+import { jobStatusLabels } from '../../shared/lib/jobStatus';
 
 const JobDashboard: React.FC = () => {
     const [jobs, setJobs] = useState<Job[]>([]);
@@ -20,28 +24,52 @@ const JobDashboard: React.FC = () => {
     const [totalPages, setTotalPages] = useState(1);
     const [search, setSearch] = useState('');
     const [query, setQuery] = useState('');
+    const [nearInput, setNearInput] = useState('');
+    const [near, setNear] = useState('');
+    const [radiusKm, setRadiusKm] = useState<number | ''>('');
     const [statusFilter, setStatusFilter] = useState<Job['status'] | ''>('');
+    const [listing, setListing] = useState<'active' | 'archived' | 'deleted'>('active');
+    const [facets, setFacets] = useState<JobFacets | null>(null);
+    const [facetSelection, setFacetSelection] = useState<JobFacetSelection>({});
     const [error, setError] = useState('');    const { user } = useAuth();
     const navigate = useNavigate();
     const [applicationCounts, setApplicationCounts] = useState<Record<number, number>>({});
     const [appliedJobIds, setAppliedJobIds] = useState<Set<number>>(new Set());
+    const [applyJob, setApplyJob] = useState<Job | null>(null);
     const { showToast } = useToast();
 
     const fetchJobs = React.useCallback(async () => {
         setLoading(true);
         try {
-            const path = user?.role === Role.RECRUITER ? '/jobs/mine' : '/jobs';
-            const response = await api.get<PaginatedResponse<Job>>(path, {
-                params: { page, limit: 5, q: query, status: statusFilter }
+            const isRecruiter = user?.role === Role.RECRUITER;
+            const geo = !isRecruiter && near ? { near, radius_km: radiusKm || undefined } : {};
+            const archive = isRecruiter && listing !== 'active' ? { [listing]: true } : {};
+            const response = await api.get<PaginatedJobs>(isRecruiter ? '/jobs/mine' : '/jobs', {
+                params: { page, limit: 5, q: query, status: statusFilter, ...(isRecruiter ? {} : facetSelection), ...geo, ...archive },
+                paramsSerializer: { indexes: null },
             });
             setJobs(response.data.data || []);
+            setFacets(response.data.facets || null);
             setTotalPages(response.data.meta?.total_pages || 1);
-        } catch {
-            setError('Falha ao carregar vagas.');
+            setError('');
+        } catch (err: unknown) {
+            const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error;
+            setError(message ? `Falha ao carregar vagas: ${message}` : 'Falha ao carregar vagas.');
         } finally {
             setLoading(false);
         }
-    }, [page, query, statusFilter, user?.role]);
+    }, [page, query, statusFilter, facetSelection, near, radiusKm, listing, user?.role]);
+
+    const handleRestore = async (job: Job) => {
+        try {
+            await api.post(`/jobs/${job.id}/restore`);
+            showToast({ message: 'Vaga restaurada', severity: 'success' });
+            await fetchJobs();
+        } catch (err: unknown) {
+            const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao restaurar a vaga';
+            showToast({ message, severity: 'error' });
+        }
+    };
 
     const fetchStats = React.useCallback(async () => {
         try {
@@ -140,14 +168,18 @@ const JobDashboard: React.FC = () => {
     const handleSearch = () => {
         setPage(1);
         setQuery(search);
+        setNear(nearInput.trim());
     };
 
-    const handleApply = async (jobId: number) => {
+    const handleApply = async (form: FormData) => {
+        if (!applyJob) return;
+        const jobId = applyJob.id;
         try {
-            await api.post(`/jobs/${jobId}/apply`);
+            await api.post(`/jobs/${jobId}/apply`, form);
             showToast({ message: 'Candidatura enviada com sucesso!', severity: 'success' });
             fetchStats();
             setAppliedJobIds(prev => new Set([...Array.from(prev), jobId]));
+            setApplyJob(null);
         } catch (err: unknown) {
             const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao aplicar.';
             showToast({ message, severity: 'error' });
@@ -255,6 +287,33 @@ const JobDashboard: React.FC = () => {
                     onKeyDown={(e) => e.key === 'Enter' && handleSearch()}
                     sx={{ bgcolor: 'background.paper' }}
                 />
+                {user?.role !== Role.RECRUITER && (
+                    <>
+                        <TextField
+                            label="Perto de (cidade)"
+                            placeholder="Ex.: Belo Horizonte - MG"
+                            variant="outlined"
+                            size="small"
+                            value={nearInput}
+                            onChange={(e) => setNearInput(e.target.value)}
+                            onKeyDown={(e) => e.key === 'Enter' && handleSearch()}
+                            sx={{ bgcolor: 'background.paper', minWidth: 220 }}
+                        />
+                        <TextField
+                            label="Raio"
+                            value={radiusKm}
+                            onChange={(e) => { setPage(1); setRadiusKm(e.target.value === '' ? '' : Number(e.target.value)); }}
+                            select
+                            size="small"
+                            sx={{ minWidth: 140 }}
+                        >
+                            <MenuItem value="">Qualquer distância</MenuItem>
+                            {[10, 30, 50, 100].map(km => (
+                                <MenuItem key={km} value={km}>Até {km} km</MenuItem>
+                            ))}
+                        </TextField>
+                    </>
+                )}
                 <Button variant="contained" onClick={handleSearch}>Buscar</Button>
                 <TextField
                     label="Status da Vaga"
@@ -265,11 +324,38 @@ const JobDashboard: React.FC = () => {
                     sx={{ minWidth: 180 }}
                 >
                     <MenuItem value="">Todas</MenuItem>
+                    {user?.role === Role.RECRUITER && <MenuItem value="DRAFT">Rascunho</MenuItem>}
+                    {user?.role === Role.RECRUITER && <MenuItem value="PENDING_APPROVAL">Aguardando aprovação</MenuItem>}
+                    {user?.role === Role.RECRUITER && <MenuItem value="SCHEDULED">Agendada</MenuItem>}
                     <MenuItem value="OPEN">Aberta</MenuItem>
+                    <MenuItem value="PAUSED">Pausada</MenuItem>
                     <MenuItem value="CLOSED">Fechada</MenuItem>
+                    <MenuItem value="EXPIRED">Expirada</MenuItem>
                 </TextField>
+                {user?.role === Role.RECRUITER && (
+                    <TextField
+                        label="Exibir"
+                        value={listing}
+                        onChange={(e) => { setPage(1); setListing(e.target.value as typeof listing); }}
+                        select
+                        size="small"
+                        sx={{ minWidth: 150 }}
+                    >
+                        <MenuItem value="active">Ativas</MenuItem>
+                        <MenuItem value="archived">Arquivadas</MenuItem>
+                        <MenuItem value="deleted">Excluídas</MenuItem>
+                    </TextField>
+                )}
             </Box>
 
+            {facets && (
+                <JobFacetFilters
+                    facets={facets}
+                    selected={facetSelection}
+                    onChange={(selected) => { setPage(1); setFacetSelection(selected); }}
+                />
+            )}
+
             {error && <Alert severity="error" sx={{ mb: 2 }}>{error}</Alert>}
 
             {loading ? (
@@ -292,12 +378,17 @@ const JobDashboard: React.FC = () => {
                                                             Aplicado
                                                         </Button>
                                                     ) : (
-                                                        <Button variant="contained" size="small" onClick={() => handleApply(job.id)}>
+                                                        <Button variant="contained" size="small" onClick={() => setApplyJob(job)}>
                                                             Aplicar
                                                         </Button>
                                                     )
                                                 )}
-                                                {user?.role === Role.RECRUITER && (
+                                                {user?.role === Role.RECRUITER && job.deleted_at && (
+                                                    <Button variant="outlined" size="small" onClick={() => handleRestore(job)}>
+                                                        Restaurar
+                                                    </Button>
+                                                )}
+                                                {user?.role === Role.RECRUITER && !job.deleted_at && (
                                                     <Button variant="outlined" size="small" onClick={() => navigate(`/jobs/${job.id}/manage`)}>
                                                         Gerenciar
                                                     </Button>
@@ -310,10 +401,10 @@ const JobDashboard: React.FC = () => {
                                             primary={
                                                 <Box display="flex" justifyContent="space-between" alignItems="center">
                                                     <Typography variant="h6" color="primary" sx={{ fontWeight: 'bold', cursor: 'pointer' }} onClick={() => navigate(`/jobs/${job.id}`)}>
-                                                        {job.title}
+                                                        {job.highlights?.title ? <Highlight text={job.highlights.title} /> : job.title}
                                                     </Typography>
                                                     <Box display="flex" alignItems="center" gap={1}>
-                                                        <Chip label={job.status} size="small" color={job.status === 'OPEN' ? 'success' : 'default'} variant="outlined" />
+                                                        <Chip label={jobStatusLabels[job.status]} size="small" color={job.status === 'OPEN' ? 'success' : 'default'} variant="outlined" />
                                                         {user?.role === Role.RECRUITER && (
                                                             <Chip label={`${applicationCounts[job.id] ?? 0} candidaturas`} size="small" variant="outlined" />
                                                         )}
@@ -324,6 +415,7 @@ const JobDashboard: React.FC = () => {
                                                 <Box mt={1}>
                                                     <Typography component="span" variant="subtitle2" color="text.primary" display="block">
                                                         {job.company || "Empresa desconhecida"} • {job.location || "Remoto"}
+                                                        {job.distance_km != null && ` • a ${job.distance_km.toLocaleString('pt-BR')} km`}
                                                     </Typography>
                                                     {!!job.recruiter_email && !job.anonymous && (
                                                         <Typography component="div" variant="caption" color="text.secondary">
@@ -331,7 +423,7 @@ const JobDashboard: React.FC = () => {
                                                         </Typography>
                                                     )}
                                                     <Typography component="div" variant="body2" color="text.secondary" sx={{ mt: 1, mb: 1, display: '-webkit-box', WebkitLineClamp: 2, WebkitBoxOrient: 'vertical', overflow: 'hidden' }}>
-                                                        {job.description}
+                                                        {job.highlights?.description ? <Highlight text={job.highlights.description} /> : job.description}
                                                     </Typography>
                                                     <Typography variant="caption" display="block" color="text.disabled">
                                                         Publicada em {new Date(job.created_at || '').toLocaleDateString()}
@@ -364,6 +456,14 @@ const JobDashboard: React.FC = () => {
                     </Box>
                 </>
             )}
+
+            <ApplyDialog
+                open={!!applyJob}
+                jobTitle={applyJob?.title}
+                questions={applyJob?.questions}
+                onCancel={() => setApplyJob(null)}
+                onSubmit={handleApply}
+            />
         </Container>
     );
 };
@@ -3,9 +3,18 @@ import { useNavigate, useParams } from 'react-router-dom';
 import { useToast } from '../context/toastBase';
 import { Container, Box, Typography, Chip, Card, CardContent, Button, Alert, CircularProgress, Divider, Paper, TextField, MenuItem } from '@mui/material';
 import api from '../../shared/lib/api';
-import type { Job, Application, PaginatedResponse } from '../../domain/types';
+import type { Job, Application, OrganizationMember, PaginatedResponse } from '../../domain/types';
 import { Role } from '../../domain/types';
 import { useAuth } from '../context/useAuth';
+import ApplyDialog from '../components/dialogs/ApplyDialog';
+import ApplicationNotes from '../components/ApplicationNotes';
+import ApplicationScorecard from '../components/ApplicationScorecard';
+import ApplicationInterviews from '../components/ApplicationInterviews';
+import ApplicationOffers from '../components/ApplicationOffers';
+import JobInterviewSlots from '../components/JobInterviewSlots';
+import { formatSalary } from '../../shared/lib/salary';
+import { employmentTypeLabels, seniorityLabels, workModelLabels } from '../../shared/lib/taxonomy';
+import { jobStatusLabels } from '../../shared/lib/jobStatus';
 
 const JobDetails: React.FC = () => {
   const { id } = useParams();
@@ -15,11 +24,15 @@ const JobDetails: React.FC = () => {
 
   const [job, setJob] = useState<Job | null>(null);
   const [apps, setApps] = useState<Application[]>([]);
+  const [team, setTeam] = useState<OrganizationMember[]>([]);
   const [hasApplied, setHasApplied] = useState<boolean>(false);
   const [statusFilter, setStatusFilter] = useState<string>('');
   const [search, setSearch] = useState<string>('');
+  const [sort, setSort] = useState<string>('');
+  const [minScore, setMinScore] = useState<string>('');
   const [error, setError] = useState('');
   const [loading, setLoading] = useState(true);
+  const [applyOpen, setApplyOpen] = useState(false);
   const { showToast } = useToast();
 
   const fetchJob = useCallback(async () => {
@@ -37,11 +50,28 @@ const JobDetails: React.FC = () => {
   const fetchApplications = useCallback(async () => {
     if (user?.role !== Role.RECRUITER) return;
     try {
-      const res = await api.get<PaginatedResponse<Application>>(`/jobs/${jobId}/applications`, { params: { page: 1, limit: 20 } });
+      const res = await api.get<PaginatedResponse<Application>>(`/jobs/${jobId}/applications`, {
+        params: { page: 1, limit: 20, sort: sort || undefined, min_score: minScore || undefined },
+      });
       setApps(res.data?.data || []);
     } catch {
     }
-  }, [jobId, user?.role]);
+  }, [jobId, user?.role, sort, minScore]);
+
+  const organizationId = job?.organization_id;
+  const fetchTeam = useCallback(async () => {
+    if (user?.role !== Role.RECRUITER || !organizationId) return;
+    try {
+      const res = await api.get<OrganizationMember[]>(`/organizations/${organizationId}/members`);
+      setTeam(res.data || []);
+    } catch {
+      setTeam([]);
+    }
+  }, [organizationId, user?.role]);
+
+  useEffect(() => {
+    fetchTeam();
+  }, [fetchTeam]);
 
   const fetchHasApplied = useCallback(async () => {
     if (user?.role !== Role.CANDIDATE) return;
@@ -63,11 +93,12 @@ const JobDetails: React.FC = () => {
     fetchHasApplied();
   }, [fetchJob, fetchApplications, fetchHasApplied, jobId]);
 
-  const handleApply = async () => {
+  const handleApply = async (form: FormData) => {
     try {
-      await api.post(`/jobs/${jobId}/apply`);
+      await api.post(`/jobs/${jobId}/apply`, form);
       showToast({ message: 'Candidatura enviada com sucesso', severity: 'success' });
       setHasApplied(true);
+      setApplyOpen(false);
     } catch (err: unknown) {
       const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao aplicar.';
       showToast({ message, severity: 'error' });
@@ -82,9 +113,18 @@ const JobDetails: React.FC = () => {
     <Container maxWidth="md" sx={{ mt: 4, mb: 4 }}>
       <Box display="flex" justifyContent="space-between" alignItems="center" mb={2}>
         <Typography variant="h4" fontWeight="bold">{job.title}</Typography>
-        <Chip label={job.status === 'OPEN' ? 'Aberta' : 'Fechada'} color={job.status === 'OPEN' ? 'success' : 'default'} variant="outlined" />
+        <Chip label={jobStatusLabels[job.status]} color={job.status === 'OPEN' ? 'success' : 'default'} variant="outlined" />
       </Box>
       <Typography variant="subtitle1" color="text.secondary" mb={1}>{job.company || 'Empresa desconhecida'} • {job.location || 'Remoto'}</Typography>
+      <Box display="flex" gap={1} flexWrap="wrap" mb={1}>
+        {job.category && <Chip size="small" label={job.category.name} />}
+        {job.employment_type && <Chip size="small" variant="outlined" label={employmentTypeLabels[job.employment_type]} />}
+        {job.seniority && <Chip size="small" variant="outlined" label={seniorityLabels[job.seniority]} />}
+        {job.work_model && <Chip size="small" variant="outlined" label={workModelLabels[job.work_model]} />}
+      </Box>
+      {job.expires_at && (
+        <Typography variant="body2" color="text.secondary" mb={1}>Inscrições até {new Date(job.expires_at).toLocaleString()}</Typography>
+      )}
       {!!job.recruiter_email && !job.anonymous && (
         <Typography variant="caption" color="text.secondary" mb={2}>Contato do recrutador: {job.recruiter_email}</Typography>
       )}
@@ -100,11 +140,11 @@ const JobDetails: React.FC = () => {
               <Typography variant="body2" sx={{ mt: 1 }}>{job.requirements}</Typography>
             </>
           )}
-          {job.salary && (
+          {(job.salary || job.salary_text) && (
             <>
               <Divider sx={{ my: 2 }} />
               <Typography variant="h6">Salário</Typography>
-              <Typography variant="body2" sx={{ mt: 1 }}>{job.salary}</Typography>
+              <Typography variant="body2" sx={{ mt: 1 }}>{job.salary ? formatSalary(job.salary) : job.salary_text}</Typography>
             </>
           )}
         </CardContent>
@@ -112,7 +152,7 @@ const JobDetails: React.FC = () => {
 
       <Box display="flex" gap={2}>
         {user?.role === Role.CANDIDATE && job.status === 'OPEN' && (
-          <Button variant="contained" onClick={handleApply} disabled={hasApplied}>{hasApplied ? 'Aplicado' : 'Aplicar'}</Button>
+          <Button variant="contained" onClick={() => setApplyOpen(true)} disabled={hasApplied}>{hasApplied ? 'Aplicado' : 'Aplicar'}</Button>
         )}
         {user?.role === Role.RECRUITER && (
           <Button variant="outlined" onClick={() => navigate(`/jobs/${jobId}/manage`)}>Gerenciar Vaga</Button>
@@ -120,6 +160,8 @@ const JobDetails: React.FC = () => {
         <Button variant="outlined" onClick={() => navigate('/jobs')}>Voltar para Vagas</Button>
       </Box>
 
+      {user?.role === Role.RECRUITER && <JobInterviewSlots jobId={jobId} team={team} />}
+
       {user?.role === Role.RECRUITER && (
         <Card elevation={0} variant="outlined" sx={{ mt: 4 }}>
           <CardContent>
@@ -145,6 +187,29 @@ const JobDetails: React.FC = () => {
                 <MenuItem value="REJECTED">Rejeitado</MenuItem>
                 <MenuItem value="HIRED">Contratado</MenuItem>
               </TextField>
+              <TextField
+                label="Ordenar"
+                value={sort}
+                onChange={(e) => setSort(e.target.value)}
+                select
+                size="small"
+                sx={{ minWidth: 160 }}
+              >
+                <MenuItem value="">Mais recentes</MenuItem>
+                <MenuItem value="score_desc">Maior nota</MenuItem>
+                <MenuItem value="score_asc">Menor nota</MenuItem>
+              </TextField>
+              <TextField
+                label="Nota mínima"
+                value={minScore}
+                onChange={(e) => setMinScore(e.target.value)}
+                select
+                size="small"
+                sx={{ minWidth: 130 }}
+              >
+                <MenuItem value="">Qualquer</MenuItem>
+                {[2, 3, 4, 4.5].map((n) => <MenuItem key={n} value={String(n)}>{n}+</MenuItem>)}
+              </TextField>
             </Box>
             {apps.length === 0 ? (
               <Typography color="text.secondary">Nenhuma candidatura ainda.</Typography>
@@ -161,12 +226,57 @@ const JobDetails: React.FC = () => {
                       return name.includes(term) || idMatch;
                     })
                     .map((a) => (
-                    <Box key={a.id} display="flex" justifyContent="space-between" alignItems="center" py={1}>
+                    <Box key={a.id} display="flex" justifyContent="space-between" alignItems="flex-start" py={1}>
                       <Box>
                         <Typography variant="subtitle2">{a.candidate_name || `Candidato #${a.candidate_id}`}</Typography>
+                        {a.profile?.headline && (
+                          <Typography variant="body2">{a.profile.headline}</Typography>
+                        )}
                         <Typography variant="caption" color="text.secondary">ID: {a.candidate_id} • Aplicado em {a.applied_at}</Typography>
+                        {a.profile && (
+                          <Typography variant="caption" color="text.secondary" display="block">
+                            {[
+                              a.profile.current_title && `${a.profile.current_title}${a.profile.current_company ? ` @ ${a.profile.current_company}` : ''}`,
+                              `${a.profile.years_of_experience} anos de experiência`,
+                              a.profile.location,
+                              a.profile.skills.slice(0, 5).join(', '),
+                            ].filter(Boolean).join(' • ')}
+                          </Typography>
+                        )}
+                        {!!a.answers?.length && (
+                          <Box mt={0.5}>
+                            {a.answers.map((ans) => (
+                              <Typography key={ans.question_id} variant="caption" display="block" color={ans.knocked_out ? 'error' : 'text.secondary'}>
+                                {ans.prompt}: {ans.values.join(', ')}
+                              </Typography>
+                            ))}
+                          </Box>
+                        )}
+                        {!!a.attachments?.length && (
+                          <Box display="flex" gap={1} mt={0.5}>
+                            {a.attachments.map((f) => (
+                              <Button key={f.id} size="small" href={f.url} target="_blank" rel="noopener noreferrer" disabled={!f.url}>
+                                {f.kind === 'RESUME' ? 'Currículo' : 'Carta de apresentação'}
+                              </Button>
+                            ))}
+                          </Box>
+                        )}
+                        <ApplicationInterviews applicationId={a.id} team={team} />
+                        <ApplicationOffers applicationId={a.id} canManage onChanged={fetchApplications} />
+                        <ApplicationScorecard applicationId={a.id} onSubmitted={fetchApplications} />
+                        <ApplicationNotes applicationId={a.id} notes={a.notes || []} team={team} currentUserId={user?.id} />
+                      </Box>
+                      <Box display="flex" gap={1}>
+                        {!!a.scorecard_count && (
+                          <Chip
+                            size="small"
+                            variant="outlined"
+                            label={a.average_score !== undefined ? `Nota ${a.average_score.toFixed(2)} (${a.scorecard_count})` : `${a.scorecard_count} avaliação(ões)`}
+                          />
+                        )}
+                        {a.flagged && <Chip label="Sinalizado" size="small" color="warning" variant="outlined" />}
+                        <Chip label={a.status} size="small" variant="outlined" />
                       </Box>
-                      <Chip label={a.status} size="small" variant="outlined" />
                     </Box>
                   ))}
                 </Box>
@@ -175,6 +285,14 @@ const JobDetails: React.FC = () => {
           </CardContent>
         </Card>
       )}
+
+      <ApplyDialog
+        open={applyOpen}
+        jobTitle={job.title}
+        questions={job.questions}
+        onCancel={() => setApplyOpen(false)}
+        onSubmit={handleApply}
+      />
     </Container>
   );
 };
@@ -1,12 +1,17 @@
 import React, { useEffect, useState, useCallback } from 'react';
 import { useParams, useNavigate } from 'react-router-dom';
-import { Container, Box, Typography, TextField, Button, Alert, CircularProgress, Card, CardContent, Divider, Chip, MenuItem, Select, FormControl, InputLabel } from '@mui/material';
+import { Container, Box, Typography, TextField, Button, Alert, CircularProgress, Card, CardContent, Divider, Chip, MenuItem, Select, FormControl, InputLabel, FormControlLabel, Checkbox } from '@mui/material';
 import api from '../../shared/lib/api';
 import ConfirmDialog from '../components/dialogs/ConfirmDialog';
 import FeedbackDialog from '../components/dialogs/FeedbackDialog';
-import type { Job, Application, PaginatedResponse } from '../../domain/types';
+import type { Job, JobApproval, Application, PaginatedResponse } from '../../domain/types';
 import { Role } from '../../domain/types';
 import { useAuth } from '../context/useAuth';
+import SalaryFields from '../components/forms/SalaryFields';
+import TaxonomyFields from '../components/forms/TaxonomyFields';
+import { emptySalaryForm, toSalaryForm, toSalaryInput } from '../../shared/lib/salary';
+import { emptyTaxonomyForm, toTaxonomyForm, toTaxonomyInput } from '../../shared/lib/taxonomy';
+import { approvalStatusLabels, fromDateTimeInput, jobStatusLabels, toDateTimeInput } from '../../shared/lib/jobStatus';
 
 const ManageJob: React.FC = () => {
   const { id } = useParams();
@@ -16,6 +21,8 @@ const ManageJob: React.FC = () => {
 
   const [job, setJob] = useState<Job | null>(null);
   const [apps, setApps] = useState<Application[]>([]);
+  const [approvals, setApprovals] = useState<JobApproval[]>([]);
+  const [approvalComment, setApprovalComment] = useState('');
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState('');
   const [success, setSuccess] = useState('');
@@ -23,6 +30,8 @@ const ManageJob: React.FC = () => {
   const [isEditing, setIsEditing] = useState<boolean>(false);
   const [openConfirmSave, setOpenConfirmSave] = useState<boolean>(false);
   const [openConfirmCancelEdit, setOpenConfirmCancelEdit] = useState<boolean>(false);
+  const [openConfirmDelete, setOpenConfirmDelete] = useState<boolean>(false);
+  const [restoreRejected, setRestoreRejected] = useState<boolean>(true);
   const [openFeedback, setOpenFeedback] = useState<boolean>(false);
   const [feedbackMessage, setFeedbackMessage] = useState<string>('');
 
@@ -32,8 +41,12 @@ const ManageJob: React.FC = () => {
     company: '',
     location: '',
     requirements: '',
-    salary: '',
+    taxonomy: emptyTaxonomyForm,
+    salary: emptySalaryForm,
     status: 'OPEN' as Job['status'],
+    publishAt: '',
+    expiresAt: '',
+    openings: 1,
   });
 
   const fetchAll = useCallback(async () => {
@@ -48,16 +61,18 @@ const ManageJob: React.FC = () => {
         company: jobRes.data.company,
         location: jobRes.data.location,
         requirements: jobRes.data.requirements || '',
-        salary: jobRes.data.salary || '',
+        taxonomy: toTaxonomyForm(jobRes.data),
+        salary: toSalaryForm(jobRes.data.salary),
         status: jobRes.data.status,
+        publishAt: toDateTimeInput(jobRes.data.publish_at),
+        expiresAt: toDateTimeInput(jobRes.data.expires_at),
+        openings: jobRes.data.openings ?? 1,
       });
+      const approvalsRes = await api.get<JobApproval[]>(`/jobs/${jobId}/approvals`);
+      setApprovals(approvalsRes.data || []);
       const appsRes = await api.get<PaginatedResponse<Application>>(`/jobs/${jobId}/applications`, { params: { page: 1, limit: 50 } });
       const list = appsRes.data?.data || [];
       setApps(list);
-      const hired = list.find(a => a.status === 'HIRED');
-      if (hired) {
-        setSelectedCandidate(hired.candidate_id);
-      }
     } catch {
       setError('Falha ao carregar dados da vaga');
     } finally {
@@ -89,6 +104,107 @@ const ManageJob: React.FC = () => {
     }
   };
 
+  const handleHire = async () => {
+    setError('');
+    setSuccess('');
+    if (!selectedCandidate || typeof selectedCandidate !== 'number') {
+      setError('Selecione um candidato para contratar');
+      return;
+    }
+    try {
+      const res = await api.post<{ remaining: number; closed: boolean }>(`/jobs/${jobId}/hire`, { candidate_id: selectedCandidate });
+      setSelectedCandidate('');
+      setOpenFeedback(true);
+      setFeedbackMessage(res.data.closed
+        ? 'Candidato contratado. Todas as vagas foram preenchidas e a vaga foi encerrada.'
+        : `Candidato contratado. Restam ${res.data.remaining} vaga(s).`);
+      await fetchAll();
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao contratar o candidato';
+      setError(message);
+    }
+  };
+
+  const handlePublish = async () => {
+    setError('');
+    setSuccess('');
+    try {
+      await api.post(`/jobs/${jobId}/publish`);
+      setOpenFeedback(true);
+      setFeedbackMessage('Vaga publicada com sucesso');
+      await fetchAll();
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao publicar a vaga';
+      setError(message);
+    }
+  };
+
+  // Submitting, approving and rejecting share the comment field; the API
+  // decides who may approve (owners and hiring managers, not the requester).
+  const handleApproval = async (action: 'submit' | 'approve' | 'reject') => {
+    setError('');
+    setSuccess('');
+    const messages = {
+      submit: 'Vaga enviada para aprovação',
+      approve: 'Vaga aprovada',
+      reject: 'Vaga reprovada e devolvida como rascunho',
+    };
+    try {
+      await api.post(`/jobs/${jobId}/${action}`, { comment: approvalComment });
+      setApprovalComment('');
+      setOpenFeedback(true);
+      setFeedbackMessage(messages[action]);
+      await fetchAll();
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao processar a aprovação';
+      setError(message);
+    }
+  };
+
+  const handleReopen = async () => {
+    setError('');
+    setSuccess('');
+    try {
+      const res = await api.post<{ status: string; restored_applications: number }>(`/jobs/${jobId}/reopen`, {
+        restore_rejected: restoreRejected,
+        expires_at: form.expiresAt !== toDateTimeInput(job?.expires_at) ? fromDateTimeInput(form.expiresAt) : undefined,
+      });
+      setOpenFeedback(true);
+      const reopened = res.data.status === 'PENDING_APPROVAL' ? 'Vaga enviada para aprovação' : 'Vaga reaberta';
+      setFeedbackMessage(`${reopened}. ${res.data.restored_applications} candidatura(s) reativada(s).`);
+      await fetchAll();
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao reabrir a vaga';
+      setError(message);
+    }
+  };
+
+  const handleArchive = async (archive: boolean) => {
+    setError('');
+    setSuccess('');
+    try {
+      await api.post(`/jobs/${jobId}/${archive ? 'archive' : 'unarchive'}`);
+      setOpenFeedback(true);
+      setFeedbackMessage(archive ? 'Vaga arquivada' : 'Vaga desarquivada');
+      await fetchAll();
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao arquivar a vaga';
+      setError(message);
+    }
+  };
+
+  const handleDelete = async () => {
+    setError('');
+    setSuccess('');
+    try {
+      await api.delete(`/jobs/${jobId}`);
+      navigate('/jobs');
+    } catch (err: unknown) {
+      const message = (err as { response?: { data?: { error?: string } } }).response?.data?.error || 'Falha ao excluir a vaga';
+      setError(message);
+    }
+  };
+
   const handleUpdate = async () => {
     setError('');
     setSuccess('');
@@ -99,8 +215,14 @@ const ManageJob: React.FC = () => {
         company: form.company,
         location: form.location,
         requirements: form.requirements,
-        salary: form.salary,
-        status: form.status,
+        ...toTaxonomyInput(form.taxonomy),
+        salary: toSalaryInput(form.salary),
+        // Unchanged status and dates are left out: the API rejects moving a
+        // published job's publish_at or keeping an expiration in the past.
+        status: form.status !== job?.status ? form.status : undefined,
+        publish_at: form.publishAt !== toDateTimeInput(job?.publish_at) ? fromDateTimeInput(form.publishAt) : undefined,
+        expires_at: form.expiresAt !== toDateTimeInput(job?.expires_at) ? fromDateTimeInput(form.expiresAt) : undefined,
+        openings: form.openings !== job?.openings ? form.openings : undefined,
       });
       setSuccess('Vaga atualizada com sucesso');
       if (form.status === 'CLOSED') {
@@ -129,7 +251,10 @@ const ManageJob: React.FC = () => {
     <Container maxWidth="md" sx={{ mt: 4, mb: 4 }}>
       <Box display="flex" justifyContent="space-between" alignItems="center" mb={2}>
         <Typography variant="h4" fontWeight="bold">Gerenciar Vaga</Typography>
-        <Chip label={form.status === 'OPEN' ? 'Aberta' : 'Fechada'} color={form.status === 'OPEN' ? 'success' : 'default'} variant="outlined" />
+        <Box display="flex" gap={1}>
+          {job.archived_at && <Chip label="Arquivada" variant="outlined" />}
+          <Chip label={jobStatusLabels[job.status]} color={job.status === 'OPEN' ? 'success' : 'default'} variant="outlined" />
+        </Box>
       </Box>
       {error && <Alert severity="error" sx={{ mb: 2 }}>{error}</Alert>}
       {success && <Alert severity="success" sx={{ mb: 2 }}>{success}</Alert>}
@@ -142,21 +267,34 @@ const ManageJob: React.FC = () => {
           <TextField fullWidth label="Local" value={form.location} onChange={(e) => setForm({ ...form, location: e.target.value })} margin="normal" disabled={!isEditing} />
           <TextField fullWidth label="Descrição" value={form.description} onChange={(e) => setForm({ ...form, description: e.target.value })} margin="normal" multiline rows={4} disabled={!isEditing} />
           <TextField fullWidth label="Requisitos" value={form.requirements} onChange={(e) => setForm({ ...form, requirements: e.target.value })} margin="normal" multiline rows={3} disabled={!isEditing} />
-          <TextField fullWidth label="Salário" value={form.salary} onChange={(e) => setForm({ ...form, salary: e.target.value })} margin="normal" disabled={!isEditing} />
+          <TaxonomyFields value={form.taxonomy} onChange={(taxonomy) => setForm({ ...form, taxonomy })} disabled={!isEditing} />
+          <SalaryFields value={form.salary} onChange={(salary) => setForm({ ...form, salary })} disabled={!isEditing} />
+          <TextField fullWidth type="number" label="Número de Vagas" value={form.openings} onChange={(e) => setForm({ ...form, openings: Math.max(1, Number(e.target.value) || 1) })} margin="normal" inputProps={{ min: 1 }} disabled={!isEditing} />
+          <Box display="flex" gap={2}>
+            <TextField fullWidth type="datetime-local" label="Publicar em" value={form.publishAt} onChange={(e) => setForm({ ...form, publishAt: e.target.value })} margin="normal" InputLabelProps={{ shrink: true }} disabled={!isEditing || (job.status !== 'DRAFT' && job.status !== 'SCHEDULED')} />
+            <TextField fullWidth type="datetime-local" label="Expira em" value={form.expiresAt} onChange={(e) => setForm({ ...form, expiresAt: e.target.value })} margin="normal" InputLabelProps={{ shrink: true }} disabled={!isEditing} />
+          </Box>
 
           <FormControl fullWidth margin="normal" disabled={!isEditing}>
             <InputLabel>Status</InputLabel>
             <Select value={form.status} label="Status" onChange={(e) => setForm({ ...form, status: e.target.value as Job['status'] })}>
+              <MenuItem value="DRAFT" disabled>Rascunho</MenuItem>
+              <MenuItem value="PENDING_APPROVAL" disabled>Aguardando aprovação</MenuItem>
+              <MenuItem value="SCHEDULED" disabled>Agendada</MenuItem>
               <MenuItem value="OPEN">Aberta</MenuItem>
               <MenuItem value="PAUSED">Pausada</MenuItem>
-              <MenuItem value="CLOSED">Fechada</MenuItem>
+              <MenuItem value="CLOSED" disabled>Fechada</MenuItem>
+              <MenuItem value="EXPIRED" disabled>Expirada</MenuItem>
             </Select>
           </FormControl>
 
           <Box mt={2} display="flex" gap={2}>
-            {!isEditing && (
+            {!isEditing && job.status !== 'PENDING_APPROVAL' && job.status !== 'CLOSED' && (
               <Button variant="contained" color="primary" onClick={() => setIsEditing(true)}>Editar</Button>
             )}
+            {!isEditing && job.status === 'DRAFT' && (
+              <Button variant="contained" color="success" onClick={handlePublish}>Publicar</Button>
+            )}
             {isEditing && (
               <>
                 <Button variant="contained" color="primary" onClick={() => setOpenConfirmSave(true)}>Salvar Alterações</Button>
@@ -165,26 +303,99 @@ const ManageJob: React.FC = () => {
             )}
             <Button variant="outlined" onClick={() => navigate(`/jobs/${jobId}`)}>Voltar</Button>
           </Box>
+          {!isEditing && (
+            <Box mt={2} display="flex" gap={2}>
+              {job.archived_at ? (
+                <Button variant="text" onClick={() => handleArchive(false)}>Desarquivar</Button>
+              ) : (['DRAFT', 'CLOSED', 'EXPIRED'].includes(job.status) && (
+                <Button variant="text" onClick={() => handleArchive(true)}>Arquivar</Button>
+              ))}
+              {job.status !== 'OPEN' && job.status !== 'PENDING_APPROVAL' && (
+                <Button variant="text" color="error" onClick={() => setOpenConfirmDelete(true)}>Excluir</Button>
+              )}
+            </Box>
+          )}
         </CardContent>
       </Card>
 
+      {job.status === 'CLOSED' && (
+        <Card elevation={1} sx={{ mb: 3 }}>
+          <CardContent>
+            <Typography variant="h6" mb={2}>Reabrir Vaga</Typography>
+            <Typography variant="body2" color="text.secondary">O candidato contratado continua contratado. Candidatos recusados manualmente continuam recusados.</Typography>
+            <FormControlLabel
+              control={<Checkbox checked={restoreRejected} onChange={(e) => setRestoreRejected(e.target.checked)} />}
+              label="Reativar candidatos recusados no encerramento da vaga"
+            />
+            <TextField fullWidth type="datetime-local" label="Nova data de expiração (opcional)" value={form.expiresAt} onChange={(e) => setForm({ ...form, expiresAt: e.target.value })} margin="normal" InputLabelProps={{ shrink: true }} />
+            <Box mt={1}>
+              <Button variant="contained" onClick={handleReopen}>Reabrir</Button>
+            </Box>
+          </CardContent>
+        </Card>
+      )}
+
+      {(job.status === 'DRAFT' || job.status === 'PENDING_APPROVAL' || approvals.length > 0) && (
+        <Card elevation={1} sx={{ mb: 3 }}>
+          <CardContent>
+            <Typography variant="h6" mb={2}>Aprovação</Typography>
+            {approvals.map((a) => (
+              <Box key={a.id} mb={2}>
+                <Box display="flex" gap={1} alignItems="center">
+                  <Chip size="small" label={approvalStatusLabels[a.status]} color={a.status === 'APPROVED' ? 'success' : a.status === 'REJECTED' ? 'error' : 'default'} />
+                  <Typography variant="body2" color="text.secondary">
+                    Enviada por {a.requested_by_name || `#${a.requested_by_id}`} em {new Date(a.created_at).toLocaleString('pt-BR')}
+                  </Typography>
+                </Box>
+                {a.comment && <Typography variant="body2" mt={0.5}>{a.comment}</Typography>}
+                {a.decided_at && (
+                  <Typography variant="body2" color="text.secondary" mt={0.5}>
+                    Decidida por {a.decided_by_name || `#${a.decided_by_id}`} em {new Date(a.decided_at).toLocaleString('pt-BR')}{a.decision_comment ? `: ${a.decision_comment}` : ''}
+                  </Typography>
+                )}
+              </Box>
+            ))}
+            {(job.status === 'DRAFT' || job.status === 'PENDING_APPROVAL' || job.status === 'EXPIRED') && (
+              <>
+                <Divider sx={{ my: 2 }} />
+                <TextField fullWidth label="Comentário" value={approvalComment} onChange={(e) => setApprovalComment(e.target.value)} margin="normal" multiline rows={2} />
+                <Box mt={1} display="flex" gap={2}>
+                  {(job.status === 'DRAFT' || job.status === 'EXPIRED') && (
+                    <Button variant="outlined" onClick={() => handleApproval('submit')}>Enviar para Aprovação</Button>
+                  )}
+                  {job.status === 'PENDING_APPROVAL' && (
+                    <>
+                      <Button variant="contained" color="success" onClick={() => handleApproval('approve')}>Aprovar</Button>
+                      <Button variant="outlined" color="error" disabled={!approvalComment.trim()} onClick={() => handleApproval('reject')}>Reprovar</Button>
+                    </>
+                  )}
+                </Box>
+              </>
+            )}
+          </CardContent>
+        </Card>
+      )}
+
       <Card elevation={1}>
         <CardContent>
-          <Typography variant="h6" mb={2}>Encerrar Vaga com um Candidato</Typography>
-          <Typography variant="body2" color="text.secondary">Selecione um candidato que aplicou para marcar como contratado e fechar a vaga.</Typography>
+          <Typography variant="h6" mb={2}>Contratar Candidatos</Typography>
+          <Typography variant="body2" color="text.secondary">
+            {apps.filter(a => a.status === 'HIRED').length} de {job.openings ?? 1} vaga(s) preenchida(s). Contratar mantém a vaga aberta até preencher todas; encerrar contrata e fecha a vaga na hora.
+          </Typography>
           <Divider sx={{ my: 2 }} />
           <FormControl fullWidth>
             <InputLabel>Candidato</InputLabel>
             <Select value={selectedCandidate} label="Candidato" onChange={(e) => setSelectedCandidate(Number(e.target.value))} disabled={form.status !== 'OPEN'}>
-              {apps.map((a) => (
+              {apps.filter(a => a.status === 'PENDING').map((a) => (
                 <MenuItem key={a.id} value={a.candidate_id}>
                   {(a.candidate_name || a.candidate?.name || `Candidato #${a.candidate_id}`)} • {a.status}
                 </MenuItem>
               ))}
             </Select>
           </FormControl>
-          <Box mt={2}>
-            <Button variant="contained" color="success" disabled={form.status !== 'OPEN' || !selectedCandidate} onClick={handleFinalize}>Encerrar Vaga</Button>
+          <Box mt={2} display="flex" gap={2}>
+            <Button variant="contained" color="primary" disabled={form.status !== 'OPEN' || !selectedCandidate} onClick={handleHire}>Contratar</Button>
+            <Button variant="contained" color="success" disabled={form.status !== 'OPEN' || !selectedCandidate} onClick={handleFinalize}>Contratar e Encerrar Vaga</Button>
           </Box>
         </CardContent>
       </Card>
@@ -210,13 +421,27 @@ const ManageJob: React.FC = () => {
           company: job.company,
           location: job.location,
           requirements: job.requirements || '',
-          salary: job.salary || '',
+          taxonomy: toTaxonomyForm(job),
+          salary: toSalaryForm(job.salary),
           status: job.status,
+          publishAt: toDateTimeInput(job.publish_at),
+          expiresAt: toDateTimeInput(job.expires_at),
+          openings: job.openings ?? 1,
         }); } }}
         cancelText="Não"
         confirmText="Sim"
       />
 
+      <ConfirmDialog
+        open={openConfirmDelete}
+        title="Excluir Vaga"
+        content={<>Deseja excluir esta vaga? Ela poderá ser restaurada depois.</>}
+        onCancel={() => setOpenConfirmDelete(false)}
+        onConfirm={() => { setOpenConfirmDelete(false); handleDelete(); }}
+        cancelText="Não"
+        confirmText="Sim"
+      />
+
       <FeedbackDialog
         open={openFeedback}
         title="Ação concluída"
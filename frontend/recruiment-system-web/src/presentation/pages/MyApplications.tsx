@@ -4,6 +4,8 @@ import { useNavigate } from 'react-router-dom';
 import api from '../../shared/lib/api';
 import ConfirmDialog from '../components/dialogs/ConfirmDialog';
 import FeedbackDialog from '../components/dialogs/FeedbackDialog';
+import ApplicationInterviews from '../components/ApplicationInterviews';
+import ApplicationOffers from '../components/ApplicationOffers';
 import { useToast } from '../context/toastBase';
 import type { Application, PaginatedResponse } from '../../domain/types';
 
@@ -94,6 +96,8 @@ const MyApplications: React.FC = () => {
                                         <TableCell>
                                             <Typography variant="subtitle1" fontWeight="bold">{app.job_title || 'Vaga desconhecida'}</Typography>
                                             <Typography variant="caption" color="text.secondary">ID: {app.job_id}</Typography>
+                                            <ApplicationInterviews applicationId={app.id} />
+                                            <ApplicationOffers applicationId={app.id} onChanged={fetchApplications} />
                                         </TableCell>
                                         <TableCell>{app.company || '-'}</TableCell>
                                         <TableCell>{app.applied_at || '-'}</TableCell>
@@ -0,0 +1,29 @@
+import type { Job, JobApproval } from '../../domain/types';
+
+export const jobStatusLabels: Record<Job['status'], string> = {
+  DRAFT: 'Rascunho',
+  PENDING_APPROVAL: 'Aguardando aprovação',
+  SCHEDULED: 'Agendada',
+  OPEN: 'Aberta',
+  PAUSED: 'Pausada',
+  CLOSED: 'Fechada',
+  EXPIRED: 'Expirada',
+};
+
+export const approvalStatusLabels: Record<JobApproval['status'], string> = {
+  PENDING: 'Pendente',
+  APPROVED: 'Aprovada',
+  REJECTED: 'Reprovada',
+};
+
+// <input type="datetime-local"> works with local "YYYY-MM-DDTHH:mm" values
+// while the API exchanges RFC 3339 timestamps.
+export const toDateTimeInput = (iso?: string): string => {
+  if (!iso) return '';
+  const date = new Date(iso);
+  const pad = (n: number) => String(n).padStart(2, '0');
+  return `${date.getFullYear()}-${pad(date.getMonth() + 1)}-${pad(date.getDate())}T${pad(date.getHours())}:${pad(date.getMinutes())}`;
+};
+
+export const fromDateTimeInput = (value: string): string | undefined =>
+  value ? new Date(value).toISOString() : undefined;
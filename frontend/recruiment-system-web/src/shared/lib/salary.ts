@@ -0,0 +1,53 @@
+import type { Salary, SalaryPeriod } from '../../domain/types';
+
+const periodLabels: Record<SalaryPeriod, string> = {
+  HOUR: 'hora',
+  MONTH: 'mês',
+  YEAR: 'ano',
+};
+
+export const formatSalary = (salary: Salary): string => {
+  const money = (value: number) =>
+    new Intl.NumberFormat('pt-BR', { style: 'currency', currency: salary.currency, maximumFractionDigits: 0 }).format(value);
+  let amount = '';
+  if (salary.min != null && salary.max != null) {
+    amount = salary.min === salary.max ? money(salary.min) : `${money(salary.min)} - ${money(salary.max)}`;
+  } else if (salary.min != null) {
+    amount = `A partir de ${money(salary.min)}`;
+  } else if (salary.max != null) {
+    amount = `Até ${money(salary.max)}`;
+  }
+  return `${amount} / ${periodLabels[salary.period]}`;
+};
+
+export type SalaryForm = {
+  min: string;
+  max: string;
+  currency: string;
+  period: SalaryPeriod;
+  visible: boolean;
+};
+
+export const emptySalaryForm: SalaryForm = { min: '', max: '', currency: 'BRL', period: 'MONTH', visible: true };
+
+export const toSalaryForm = (salary?: Salary): SalaryForm => {
+  if (!salary) return emptySalaryForm;
+  return {
+    min: salary.min != null ? String(salary.min) : '',
+    max: salary.max != null ? String(salary.max) : '',
+    currency: salary.currency,
+    period: salary.period,
+    visible: salary.visible ?? true,
+  };
+};
+
+export const toSalaryInput = (form: SalaryForm): Salary | undefined => {
+  if (!form.min && !form.max) return undefined;
+  return {
+    min: form.min ? Number(form.min) : undefined,
+    max: form.max ? Number(form.max) : undefined,
+    currency: form.currency,
+    period: form.period,
+    visible: form.visible,
+  };
+};
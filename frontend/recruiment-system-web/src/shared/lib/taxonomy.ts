@@ -0,0 +1,46 @@
+import type { EmploymentType, Job, Seniority, WorkModel } from '../../domain/types';
+
+export const employmentTypeLabels: Record<EmploymentType, string> = {
+  CLT: 'CLT',
+  PJ: 'PJ',
+  CONTRACT: 'Contrato',
+  INTERNSHIP: 'Estágio',
+};
+
+export const seniorityLabels: Record<Seniority, string> = {
+  INTERN: 'Estagiário',
+  JUNIOR: 'Júnior',
+  MID: 'Pleno',
+  SENIOR: 'Sênior',
+  LEAD: 'Liderança',
+};
+
+export const workModelLabels: Record<WorkModel, string> = {
+  REMOTE: 'Remoto',
+  HYBRID: 'Híbrido',
+  ONSITE: 'Presencial',
+};
+
+export type TaxonomyForm = {
+  category_id: number | '';
+  employment_type: EmploymentType | '';
+  seniority: Seniority | '';
+  work_model: WorkModel | '';
+};
+
+export const emptyTaxonomyForm: TaxonomyForm = { category_id: '', employment_type: '', seniority: '', work_model: '' };
+
+export const toTaxonomyForm = (job?: Job): TaxonomyForm => ({
+  category_id: job?.category?.id ?? '',
+  employment_type: job?.employment_type ?? '',
+  seniority: job?.seniority ?? '',
+  work_model: job?.work_model ?? '',
+});
+
+// An empty category is sent as 0, which removes the job's category.
+export const toTaxonomyInput = (form: TaxonomyForm) => ({
+  category_id: form.category_id === '' ? 0 : form.category_id,
+  employment_type: form.employment_type || undefined,
+  seniority: form.seniority || undefined,
+  work_model: form.work_model || undefined,
+});